@@ -0,0 +1,225 @@
+package powAdaptiveDifficulty
+
+import (
+	"errors"
+	"math"
+	"sync"
+	"time"
+
+	"github.com/samber/mo"
+	pow "github.com/thewizardplusplus/go-pow"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+const (
+	defaultKeyIdleTimeoutFactor = 10
+)
+
+// the load is relative to the nominal capacity, so the values above one
+// mean an overload (for example, the CPU usage divided by its desired level)
+type LoadSignal func() float64
+
+type ControllerParams struct {
+	MinLeadingZeroBitCount powValueTypes.LeadingZeroBitCount
+	MaxLeadingZeroBitCount powValueTypes.LeadingZeroBitCount
+	RateWindow             time.Duration
+	GlobalRateLimit        mo.Option[float64] // requests per second
+	KeyRateLimit           mo.Option[float64] // requests per second
+	LoadSignal             mo.Option[LoadSignal]
+	RampUpRate             mo.Option[float64] // bits per second
+	DecayRate              mo.Option[float64] // bits per second
+	KeyIdleTimeout         mo.Option[time.Duration]
+	Clock                  mo.Option[pow.Clock]
+}
+
+func (params ControllerParams) validate() error {
+	var errs []error
+	if params.MinLeadingZeroBitCount.ToInt() >
+		params.MaxLeadingZeroBitCount.ToInt() {
+		errs = append(
+			errs,
+			errors.New(
+				"minimal leading zero bit count exceeds the maximal one",
+			),
+		)
+	}
+	if params.RateWindow <= 0 {
+		errs = append(errs, errors.New("rate window must be positive"))
+	}
+
+	for _, option := range []struct {
+		name  string
+		value mo.Option[float64]
+	}{
+		{name: "global rate limit", value: params.GlobalRateLimit},
+		{name: "key rate limit", value: params.KeyRateLimit},
+		{name: "ramp-up rate", value: params.RampUpRate},
+		{name: "decay rate", value: params.DecayRate},
+	} {
+		if value, isPresent := option.value.Get(); isPresent && !(value > 0) {
+			errs = append(errs, errors.New(option.name+" must be positive"))
+		}
+	}
+
+	keyIdleTimeout, isPresent := params.KeyIdleTimeout.Get()
+	if isPresent && keyIdleTimeout <= 0 {
+		errs = append(errs, errors.New("key idle timeout must be positive"))
+	}
+
+	return errors.Join(errs...)
+}
+
+type Controller struct {
+	params         ControllerParams
+	clock          pow.Clock
+	keyIdleTimeout time.Duration
+
+	mutex       sync.Mutex
+	globalState *difficultyState
+	keyStates   map[string]*difficultyState
+	sweptAt     time.Time
+}
+
+func NewController(params ControllerParams) (*Controller, error) {
+	if err := params.validate(); err != nil {
+		return nil, err
+	}
+
+	clock := params.Clock.OrElse(pow.SystemClock{})
+	now := clock.Now()
+	controller := &Controller{
+		params: params,
+		clock:  clock,
+		keyIdleTimeout: params.KeyIdleTimeout.OrElse(
+			defaultKeyIdleTimeoutFactor * params.RateWindow,
+		),
+		globalState: newDifficultyState(now),
+		keyStates:   make(map[string]*difficultyState),
+		sweptAt:     now,
+	}
+	return controller, nil
+}
+
+// the empty key means that the request is taken into account
+// only globally
+func (controller *Controller) RecordRequest(
+	key string,
+) powValueTypes.LeadingZeroBitCount {
+	return controller.update(key, true)
+}
+
+func (controller *Controller) LeadingZeroBitCount(
+	key string,
+) powValueTypes.LeadingZeroBitCount {
+	return controller.update(key, false)
+}
+
+func (controller *Controller) KeyCount() int {
+	controller.mutex.Lock()
+	defer controller.mutex.Unlock()
+
+	controller.sweepIdleKeys(controller.clock.Now())
+	return len(controller.keyStates)
+}
+
+func (controller *Controller) update(
+	key string,
+	isRequest bool,
+) powValueTypes.LeadingZeroBitCount {
+	// the signal is called outside the lock, since it may be slow
+	var load float64
+	if loadSignal, isPresent := controller.params.LoadSignal.Get(); isPresent {
+		load = loadSignal()
+	}
+
+	controller.mutex.Lock()
+	defer controller.mutex.Unlock()
+
+	now := controller.clock.Now()
+	controller.sweepIdleKeys(now)
+
+	level := controller.updateState(
+		controller.globalState,
+		now,
+		isRequest,
+		controller.params.GlobalRateLimit,
+		load,
+	)
+	if key != "" {
+		keyState, isPresent := controller.keyStates[key]
+		if !isPresent && isRequest {
+			keyState = newDifficultyState(now)
+			controller.keyStates[key] = keyState
+		}
+		if keyState != nil {
+			keyLevel := controller.updateState(
+				keyState,
+				now,
+				isRequest,
+				controller.params.KeyRateLimit,
+				0,
+			)
+			level = max(level, keyLevel)
+		}
+	}
+
+	return controller.makeLeadingZeroBitCount(level)
+}
+
+func (controller *Controller) updateState(
+	state *difficultyState,
+	now time.Time,
+	isRequest bool,
+	rateLimit mo.Option[float64],
+	load float64,
+) float64 {
+	state.decayRate(now, controller.params.RateWindow)
+	if isRequest {
+		state.recordRequest(now, controller.params.RateWindow)
+	}
+
+	pressure := load
+	if rateLimit, isPresent := rateLimit.Get(); isPresent {
+		pressure = max(pressure, state.rate/rateLimit)
+	}
+
+	// each doubling of the pressure doubles the work required for solving
+	var targetLevel float64
+	if pressure > 1 {
+		maxLevel := controller.params.MaxLeadingZeroBitCount.ToInt() -
+			controller.params.MinLeadingZeroBitCount.ToInt()
+		targetLevel = min(math.Log2(pressure), float64(maxLevel))
+	}
+
+	state.moveLevel(now, targetLevel, controller.params)
+	return state.level
+}
+
+func (controller *Controller) makeLeadingZeroBitCount(
+	level float64,
+) powValueTypes.LeadingZeroBitCount {
+	rawValue := controller.params.MinLeadingZeroBitCount.ToInt() +
+		int(math.Round(level))
+	rawValue = min(rawValue, controller.params.MaxLeadingZeroBitCount.ToInt())
+
+	// the value is always valid, since it's between the minimal
+	// and the maximal ones
+	value, _ := powValueTypes.NewLeadingZeroBitCount(rawValue)
+	return value
+}
+
+func (controller *Controller) sweepIdleKeys(now time.Time) {
+	// the keys are swept at most once per the timeout,
+	// so the sweeping cost is amortized across the requests
+	if now.Sub(controller.sweptAt) < controller.keyIdleTimeout {
+		return
+	}
+
+	for key, state := range controller.keyStates {
+		if state.isIdle(now, controller.keyIdleTimeout, controller.params) {
+			delete(controller.keyStates, key)
+		}
+	}
+
+	controller.sweptAt = now
+}
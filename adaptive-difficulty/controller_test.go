@@ -0,0 +1,543 @@
+package powAdaptiveDifficulty
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/samber/mo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pow "github.com/thewizardplusplus/go-pow"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+type movableClock struct {
+	mutex  sync.Mutex
+	moment time.Time
+}
+
+func (clock *movableClock) Now() time.Time {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+
+	return clock.moment
+}
+
+func (clock *movableClock) Move(duration time.Duration) {
+	clock.mutex.Lock()
+	defer clock.mutex.Unlock()
+
+	clock.moment = clock.moment.Add(duration)
+}
+
+type request struct {
+	delay time.Duration
+	key   string
+	count int
+}
+
+func TestNewController(test *testing.T) {
+	type args struct {
+		params ControllerParams
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/required parameters only",
+			args: args{
+				params: ControllerParams{
+					MinLeadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(10)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					MaxLeadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(20)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					RateWindow: time.Second,
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/all parameters",
+			args: args{
+				params: ControllerParams{
+					MinLeadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(10)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					MaxLeadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(20)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					RateWindow:      time.Second,
+					GlobalRateLimit: mo.Some(100.0),
+					KeyRateLimit:    mo.Some(10.0),
+					LoadSignal:      mo.Some[LoadSignal](func() float64 { return 0 }),
+					RampUpRate:      mo.Some(1.0),
+					DecayRate:       mo.Some(0.5),
+					KeyIdleTimeout:  mo.Some(time.Minute),
+					Clock:           mo.Some[pow.Clock](pow.SystemClock{}),
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/minimal difficulty exceeds the maximal one",
+			args: args{
+				params: ControllerParams{
+					MinLeadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(20)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					MaxLeadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(10)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					RateWindow: time.Second,
+				},
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/rate window isn't positive",
+			args: args{
+				params: ControllerParams{
+					MinLeadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(10)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					MaxLeadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(20)
+						require.NoError(test, err)
+
+						return value
+					}(),
+				},
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/rates aren't positive",
+			args: args{
+				params: ControllerParams{
+					MinLeadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(10)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					MaxLeadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(20)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					RateWindow:      time.Second,
+					GlobalRateLimit: mo.Some(0.0),
+					KeyRateLimit:    mo.Some(-10.0),
+					RampUpRate:      mo.Some(0.0),
+					DecayRate:       mo.Some(-0.5),
+				},
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/key idle timeout isn't positive",
+			args: args{
+				params: ControllerParams{
+					MinLeadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(10)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					MaxLeadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(20)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					RateWindow:     time.Second,
+					KeyIdleTimeout: mo.Some(time.Duration(0)),
+				},
+			},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err := NewController(data.args.params)
+
+			data.wantErr(test, err)
+			if err == nil {
+				assert.NotNil(test, got)
+			}
+		})
+	}
+}
+
+func TestController_RecordRequest(test *testing.T) {
+	for _, data := range []struct {
+		name     string
+		params   ControllerParams
+		requests []request
+		key      string
+		want     int
+	}{
+		{
+			name: "success/without load",
+			params: ControllerParams{
+				RateWindow:      time.Second,
+				GlobalRateLimit: mo.Some(10.0),
+			},
+			requests: nil,
+			key:      "",
+			want:     10,
+		},
+		{
+			name: "success/below the global rate limit",
+			params: ControllerParams{
+				RateWindow:      time.Second,
+				GlobalRateLimit: mo.Some(10.0),
+			},
+			requests: []request{{count: 9}},
+			key:      "",
+			want:     10,
+		},
+		{
+			name: "success/above the global rate limit",
+			params: ControllerParams{
+				RateWindow:      time.Second,
+				GlobalRateLimit: mo.Some(10.0),
+			},
+			requests: []request{{count: 39}},
+			key:      "",
+			want:     12,
+		},
+		{
+			name: "success/above the global rate limit/maximal difficulty",
+			params: ControllerParams{
+				RateWindow:      time.Second,
+				GlobalRateLimit: mo.Some(10.0),
+			},
+			requests: []request{{count: 1000000}},
+			key:      "",
+			want:     20,
+		},
+		{
+			name: "success/above the global rate limit/rate is decayed",
+			params: ControllerParams{
+				RateWindow:      time.Second,
+				GlobalRateLimit: mo.Some(10.0),
+			},
+			requests: []request{{count: 39}, {delay: 10 * time.Second}},
+			key:      "",
+			want:     10,
+		},
+		{
+			name: "success/above the key rate limit/same key",
+			params: ControllerParams{
+				RateWindow:   time.Second,
+				KeyRateLimit: mo.Some(5.0),
+			},
+			requests: []request{{key: "key-1", count: 19}},
+			key:      "key-1",
+			want:     12,
+		},
+		{
+			name: "success/above the key rate limit/other key",
+			params: ControllerParams{
+				RateWindow:   time.Second,
+				KeyRateLimit: mo.Some(5.0),
+			},
+			requests: []request{{key: "key-1", count: 19}},
+			key:      "key-2",
+			want:     10,
+		},
+		{
+			name: "success/above the global rate limit/other key",
+			params: ControllerParams{
+				RateWindow:      time.Second,
+				GlobalRateLimit: mo.Some(10.0),
+				KeyRateLimit:    mo.Some(5.0),
+			},
+			requests: []request{{key: "key-1", count: 39}},
+			key:      "key-2",
+			want:     12,
+		},
+		{
+			name: "success/load signal",
+			params: ControllerParams{
+				RateWindow: time.Second,
+				LoadSignal: mo.Some[LoadSignal](func() float64 { return 8 }),
+			},
+			requests: nil,
+			key:      "",
+			want:     13,
+		},
+		{
+			name: "success/ramp-up/beginning",
+			params: ControllerParams{
+				RateWindow:      1000 * time.Second,
+				GlobalRateLimit: mo.Some(0.01),
+				RampUpRate:      mo.Some(1.0),
+			},
+			requests: []request{{count: 40}},
+			key:      "",
+			want:     10,
+		},
+		{
+			name: "success/ramp-up/middle",
+			params: ControllerParams{
+				RateWindow:      1000 * time.Second,
+				GlobalRateLimit: mo.Some(0.01),
+				RampUpRate:      mo.Some(1.0),
+			},
+			requests: []request{{count: 40}, {delay: time.Second}},
+			key:      "",
+			want:     11,
+		},
+		{
+			name: "success/ramp-up/end",
+			params: ControllerParams{
+				RateWindow:      1000 * time.Second,
+				GlobalRateLimit: mo.Some(0.01),
+				RampUpRate:      mo.Some(1.0),
+			},
+			requests: []request{{count: 40}, {delay: 3 * time.Second}},
+			key:      "",
+			want:     12,
+		},
+		{
+			name: "success/decay/middle",
+			params: ControllerParams{
+				RateWindow:      time.Second,
+				GlobalRateLimit: mo.Some(10.0),
+				DecayRate:       mo.Some(0.5),
+			},
+			requests: []request{{count: 39}, {delay: 2 * time.Second}},
+			key:      "",
+			want:     11,
+		},
+		{
+			name: "success/decay/end",
+			params: ControllerParams{
+				RateWindow:      time.Second,
+				GlobalRateLimit: mo.Some(10.0),
+				DecayRate:       mo.Some(0.5),
+			},
+			requests: []request{{count: 39}, {delay: 10 * time.Second}},
+			key:      "",
+			want:     10,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			clock := &movableClock{
+				moment: time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC),
+			}
+
+			params := data.params
+			params.MinLeadingZeroBitCount = func() powValueTypes.LeadingZeroBitCount {
+				value, err := powValueTypes.NewLeadingZeroBitCount(10)
+				require.NoError(test, err)
+
+				return value
+			}()
+			params.MaxLeadingZeroBitCount = func() powValueTypes.LeadingZeroBitCount {
+				value, err := powValueTypes.NewLeadingZeroBitCount(20)
+				require.NoError(test, err)
+
+				return value
+			}()
+			params.Clock = mo.Some[pow.Clock](clock)
+			controller, err := NewController(params)
+			require.NoError(test, err)
+
+			for _, request := range data.requests {
+				clock.Move(request.delay)
+				for range request.count {
+					controller.RecordRequest(request.key)
+				}
+			}
+			got := controller.RecordRequest(data.key)
+
+			assert.Equal(test, data.want, got.ToInt())
+		})
+	}
+}
+
+func TestController_LeadingZeroBitCount(test *testing.T) {
+	clock := &movableClock{
+		moment: time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC),
+	}
+	controller, err := NewController(ControllerParams{
+		MinLeadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+			value, err := powValueTypes.NewLeadingZeroBitCount(10)
+			require.NoError(test, err)
+
+			return value
+		}(),
+		MaxLeadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+			value, err := powValueTypes.NewLeadingZeroBitCount(20)
+			require.NoError(test, err)
+
+			return value
+		}(),
+		RateWindow:   time.Second,
+		KeyRateLimit: mo.Some(5.0),
+		Clock:        mo.Some[pow.Clock](clock),
+	})
+	require.NoError(test, err)
+
+	for range 20 {
+		controller.RecordRequest("key-1")
+	}
+
+	// reading doesn't count as a request, so it's repeatable
+	for range 10 {
+		got := controller.LeadingZeroBitCount("key-1")
+		assert.Equal(test, 12, got.ToInt())
+	}
+
+	got := controller.LeadingZeroBitCount("key-2")
+	assert.Equal(test, 10, got.ToInt())
+	assert.Equal(test, 1, controller.KeyCount())
+}
+
+func TestController_KeyCount(test *testing.T) {
+	for _, data := range []struct {
+		name   string
+		params ControllerParams
+		delay  time.Duration
+		want   int
+	}{
+		{
+			name: "success/keys are active",
+			params: ControllerParams{
+				RateWindow: time.Second,
+			},
+			delay: 5 * time.Second,
+			want:  3,
+		},
+		{
+			name: "success/keys are idle",
+			params: ControllerParams{
+				RateWindow: time.Second,
+			},
+			delay: 10 * time.Second,
+			want:  0,
+		},
+		{
+			name: "success/keys are idle/custom timeout",
+			params: ControllerParams{
+				RateWindow:     time.Second,
+				KeyIdleTimeout: mo.Some(time.Minute),
+			},
+			delay: 10 * time.Second,
+			want:  3,
+		},
+		{
+			name: "success/keys are idle/level isn't decayed yet",
+			params: ControllerParams{
+				RateWindow:   time.Second,
+				KeyRateLimit: mo.Some(1.0),
+				DecayRate:    mo.Some(0.1),
+			},
+			delay: 10 * time.Second,
+			want:  3,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			clock := &movableClock{
+				moment: time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC),
+			}
+
+			params := data.params
+			params.MinLeadingZeroBitCount = func() powValueTypes.LeadingZeroBitCount {
+				value, err := powValueTypes.NewLeadingZeroBitCount(10)
+				require.NoError(test, err)
+
+				return value
+			}()
+			params.MaxLeadingZeroBitCount = func() powValueTypes.LeadingZeroBitCount {
+				value, err := powValueTypes.NewLeadingZeroBitCount(20)
+				require.NoError(test, err)
+
+				return value
+			}()
+			params.Clock = mo.Some[pow.Clock](clock)
+			controller, err := NewController(params)
+			require.NoError(test, err)
+
+			for keyIndex := range 3 {
+				for range 10 {
+					controller.RecordRequest(fmt.Sprintf("key-%d", keyIndex))
+				}
+			}
+			clock.Move(data.delay)
+			got := controller.KeyCount()
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
+func TestController_RecordRequest_concurrently(test *testing.T) {
+	controller, err := NewController(ControllerParams{
+		MinLeadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+			value, err := powValueTypes.NewLeadingZeroBitCount(10)
+			require.NoError(test, err)
+
+			return value
+		}(),
+		MaxLeadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+			value, err := powValueTypes.NewLeadingZeroBitCount(20)
+			require.NoError(test, err)
+
+			return value
+		}(),
+		RateWindow:      time.Minute,
+		GlobalRateLimit: mo.Some(1.0),
+		KeyRateLimit:    mo.Some(1.0),
+	})
+	require.NoError(test, err)
+
+	var waitGroup sync.WaitGroup
+	for workerIndex := range 10 {
+		waitGroup.Add(1)
+		go func() {
+			defer waitGroup.Done()
+
+			for range 100 {
+				controller.RecordRequest(fmt.Sprintf("key-%d", workerIndex))
+			}
+		}()
+	}
+	waitGroup.Wait()
+
+	// about 1000 requests per minute exceed the global limit 16 times
+	got := controller.LeadingZeroBitCount("")
+	assert.GreaterOrEqual(test, got.ToInt(), 13)
+	assert.Equal(test, 10, controller.KeyCount())
+}
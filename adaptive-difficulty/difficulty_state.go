@@ -0,0 +1,82 @@
+package powAdaptiveDifficulty
+
+import (
+	"math"
+	"time"
+)
+
+type difficultyState struct {
+	rate        float64 // requests per second
+	level       float64 // extra bits above the minimal difficulty
+	updatedAt   time.Time
+	requestedAt time.Time
+}
+
+func newDifficultyState(now time.Time) *difficultyState {
+	return &difficultyState{
+		updatedAt:   now,
+		requestedAt: now,
+	}
+}
+
+// the rate is an exponentially weighted moving average,
+// so it's updated without storing the history of requests
+func (state *difficultyState) decayRate(now time.Time, window time.Duration) {
+	elapsed := now.Sub(state.updatedAt)
+	if elapsed <= 0 {
+		return
+	}
+
+	state.rate *= math.Exp(-elapsed.Seconds() / window.Seconds())
+}
+
+func (state *difficultyState) recordRequest(
+	now time.Time,
+	window time.Duration,
+) {
+	state.rate += 1 / window.Seconds()
+	state.requestedAt = now
+}
+
+func (state *difficultyState) moveLevel(
+	now time.Time,
+	targetLevel float64,
+	params ControllerParams,
+) {
+	elapsed := max(now.Sub(state.updatedAt).Seconds(), 0)
+	state.updatedAt = now
+
+	if targetLevel > state.level {
+		rampUpRate, isPresent := params.RampUpRate.Get()
+		if !isPresent {
+			state.level = targetLevel
+			return
+		}
+
+		state.level = min(state.level+rampUpRate*elapsed, targetLevel)
+	} else {
+		decayRate, isPresent := params.DecayRate.Get()
+		if !isPresent {
+			state.level = targetLevel
+			return
+		}
+
+		state.level = max(state.level-decayRate*elapsed, targetLevel)
+	}
+}
+
+// the state is idle if there were no requests for the timeout
+// and its level has already decayed to zero by now
+func (state *difficultyState) isIdle(
+	now time.Time,
+	timeout time.Duration,
+	params ControllerParams,
+) bool {
+	if now.Sub(state.requestedAt) < timeout {
+		return false
+	}
+
+	decayRate, isPresent := params.DecayRate.Get()
+	return !isPresent ||
+		state.level-decayRate*now.Sub(state.updatedAt).Seconds() <= 0
+}
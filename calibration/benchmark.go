@@ -0,0 +1,120 @@
+package powCalibration
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/samber/mo"
+	pow "github.com/thewizardplusplus/go-pow"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+const (
+	benchmarkSerializedPayload = "benchmark"
+)
+
+type BenchmarkParams struct {
+	Hash              powValueTypes.Hash
+	HashDataLayout    powValueTypes.HashDataLayout
+	MaxAttemptCount   mo.Option[int]
+	Duration          mo.Option[time.Duration]
+	ConcurrencyFactor mo.Option[int]
+}
+
+type BenchmarkResult struct {
+	AttemptCount int
+	Elapsed      time.Duration
+	Device       Device
+}
+
+func Benchmark(
+	ctx context.Context,
+	params BenchmarkParams,
+) (BenchmarkResult, error) {
+	maxAttemptCount, isMaxAttemptCountPresent := params.MaxAttemptCount.Get()
+	duration, isDurationPresent := params.Duration.Get()
+	if !isMaxAttemptCountPresent && !isDurationPresent {
+		return BenchmarkResult{}, errors.New(
+			"maximal attempt count or duration is required",
+		)
+	}
+	if isMaxAttemptCountPresent && maxAttemptCount <= 0 {
+		return BenchmarkResult{}, errors.New(
+			"maximal attempt count must be positive",
+		)
+	}
+	if isDurationPresent && duration <= 0 {
+		return BenchmarkResult{}, errors.New("duration must be positive")
+	}
+
+	// the challenge requires the entire hash sum to be zero,
+	// so it's practically never solved and all the attempts are used
+	leadingZeroBitCount, err :=
+		powValueTypes.NewLeadingZeroBitCount(params.Hash.SizeInBits())
+	if err != nil {
+		return BenchmarkResult{}, fmt.Errorf(
+			"unable to construct the leading zero bit count: %w",
+			err,
+		)
+	}
+
+	challenge, err := pow.NewChallengeBuilder().
+		SetLeadingZeroBitCount(leadingZeroBitCount).
+		SetSerializedPayload(
+			powValueTypes.NewSerializedPayload(benchmarkSerializedPayload),
+		).
+		SetHash(params.Hash).
+		SetHashDataLayout(params.HashDataLayout).
+		Build()
+	if err != nil {
+		return BenchmarkResult{}, fmt.Errorf(
+			"unable to build the challenge: %w",
+			err,
+		)
+	}
+
+	if isDurationPresent {
+		var ctxCancel context.CancelFunc
+		ctx, ctxCancel = context.WithTimeout(ctx, duration)
+		defer ctxCancel()
+	}
+
+	startedAt := time.Now()
+	_, err = challenge.Solve(ctx, pow.SolveParams{
+		MaxAttemptCount:   params.MaxAttemptCount,
+		ConcurrencyFactor: params.ConcurrencyFactor,
+	})
+	elapsed := time.Since(startedAt)
+
+	var interruptionErr pow.InterruptionError
+	if err == nil {
+		return BenchmarkResult{}, errors.New(
+			"benchmark challenge is unexpectedly solved",
+		)
+	} else if !errors.As(err, &interruptionErr) {
+		return BenchmarkResult{}, fmt.Errorf(
+			"unable to solve the challenge: %w",
+			err,
+		)
+	}
+
+	// the interruption can be caused by the parent context as well,
+	// so the actual attempt count is taken from the checkpoint
+	attemptCount := interruptionErr.Checkpoint.AttemptCount
+	device, err := NewDevice(float64(attemptCount) / elapsed.Seconds())
+	if err != nil {
+		return BenchmarkResult{}, fmt.Errorf(
+			"unable to construct the device: %w",
+			err,
+		)
+	}
+
+	result := BenchmarkResult{
+		AttemptCount: attemptCount,
+		Elapsed:      elapsed,
+		Device:       device,
+	}
+	return result, nil
+}
@@ -0,0 +1,153 @@
+package powCalibration
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/samber/mo"
+	"github.com/stretchr/testify/assert"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+func TestBenchmark(test *testing.T) {
+	type args struct {
+		params BenchmarkParams
+	}
+
+	for _, data := range []struct {
+		name             string
+		args             args
+		wantAttemptCount mo.Option[int]
+		wantErr          assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/maximal attempt count",
+			args: args{
+				params: BenchmarkParams{
+					Hash: powValueTypes.NewHashFromFactory(sha256.New),
+					HashDataLayout: powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+							":{{ .Challenge.SerializedPayload.ToString }}" +
+							":{{ .Nonce.ToString }}",
+					),
+					MaxAttemptCount: mo.Some(1000),
+				},
+			},
+			wantAttemptCount: mo.Some(1000),
+			wantErr:          assert.NoError,
+		},
+		{
+			name: "success/maximal attempt count/several workers",
+			args: args{
+				params: BenchmarkParams{
+					Hash: powValueTypes.NewHashFromFactory(sha256.New),
+					HashDataLayout: powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+							":{{ .Challenge.SerializedPayload.ToString }}" +
+							":{{ .Nonce.ToString }}",
+					),
+					MaxAttemptCount:   mo.Some(1000),
+					ConcurrencyFactor: mo.Some(4),
+				},
+			},
+			wantAttemptCount: mo.Some(1000),
+			wantErr:          assert.NoError,
+		},
+		{
+			name: "success/duration",
+			args: args{
+				params: BenchmarkParams{
+					Hash: powValueTypes.NewHashFromFactory(sha256.New),
+					HashDataLayout: powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+							":{{ .Challenge.SerializedPayload.ToString }}" +
+							":{{ .Nonce.ToString }}",
+					),
+					Duration: mo.Some(10 * time.Millisecond),
+				},
+			},
+			wantAttemptCount: mo.None[int](),
+			wantErr:          assert.NoError,
+		},
+		{
+			name: "error/without limits",
+			args: args{
+				params: BenchmarkParams{
+					Hash: powValueTypes.NewHashFromFactory(sha256.New),
+					HashDataLayout: powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+							":{{ .Challenge.SerializedPayload.ToString }}" +
+							":{{ .Nonce.ToString }}",
+					),
+				},
+			},
+			wantAttemptCount: mo.None[int](),
+			wantErr:          assert.Error,
+		},
+		{
+			name: "error/non-positive maximal attempt count",
+			args: args{
+				params: BenchmarkParams{
+					Hash: powValueTypes.NewHashFromFactory(sha256.New),
+					HashDataLayout: powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+							":{{ .Challenge.SerializedPayload.ToString }}" +
+							":{{ .Nonce.ToString }}",
+					),
+					MaxAttemptCount: mo.Some(0),
+				},
+			},
+			wantAttemptCount: mo.None[int](),
+			wantErr:          assert.Error,
+		},
+		{
+			name: "error/non-positive duration",
+			args: args{
+				params: BenchmarkParams{
+					Hash: powValueTypes.NewHashFromFactory(sha256.New),
+					HashDataLayout: powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+							":{{ .Challenge.SerializedPayload.ToString }}" +
+							":{{ .Nonce.ToString }}",
+					),
+					Duration: mo.Some(-time.Second),
+				},
+			},
+			wantAttemptCount: mo.None[int](),
+			wantErr:          assert.Error,
+		},
+		{
+			name: "error/unable to build the challenge",
+			args: args{
+				params: BenchmarkParams{
+					Hash: powValueTypes.NewHashFromFactory(sha256.New),
+					HashDataLayout: powValueTypes.MustParseHashDataLayout(
+						"dummy {{ .Dummy }}",
+					),
+					MaxAttemptCount: mo.Some(1000),
+				},
+			},
+			wantAttemptCount: mo.None[int](),
+			wantErr:          assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err := Benchmark(context.Background(), data.args.params)
+
+			data.wantErr(test, err)
+			if err != nil {
+				return
+			}
+
+			if wantAttemptCount, isPresent := data.wantAttemptCount.Get(); isPresent {
+				assert.Equal(test, wantAttemptCount, got.AttemptCount)
+			} else {
+				assert.Positive(test, got.AttemptCount)
+			}
+			assert.Positive(test, got.Elapsed)
+			assert.Positive(test, got.Device.HashRate())
+		})
+	}
+}
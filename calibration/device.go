@@ -0,0 +1,168 @@
+package powCalibration
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"time"
+
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+type Device struct {
+	hashRate float64 // attempts per second
+}
+
+func NewDevice(hashRate float64) (Device, error) {
+	if math.IsNaN(hashRate) || math.IsInf(hashRate, 0) {
+		return Device{}, errors.New("hash rate must be finite")
+	}
+	if hashRate <= 0 {
+		return Device{}, errors.New("hash rate must be positive")
+	}
+
+	device := Device{
+		hashRate: hashRate,
+	}
+	return device, nil
+}
+
+func (device Device) HashRate() float64 {
+	return device.hashRate
+}
+
+func (device Device) ExpectedSolveTime(
+	hash powValueTypes.Hash,
+	target powValueTypes.Target,
+) (time.Duration, error) {
+	solveTime, err := device.makeSolveTime(ExpectedAttemptCount(hash, target))
+	if err != nil {
+		return 0, fmt.Errorf("unable to make the solve time: %w", err)
+	}
+
+	return solveTime, nil
+}
+
+// the result is the time, within which the challenge is solved
+// with the specified probability
+func (device Device) SolveTimePercentile(
+	hash powValueTypes.Hash,
+	target powValueTypes.Target,
+	percentile float64,
+) (time.Duration, error) {
+	attemptCount, err := AttemptCountPercentile(hash, target, percentile)
+	if err != nil {
+		return 0, fmt.Errorf(
+			"unable to get the attempt count percentile: %w",
+			err,
+		)
+	}
+
+	solveTime, err := device.makeSolveTime(attemptCount)
+	if err != nil {
+		return 0, fmt.Errorf("unable to make the solve time: %w", err)
+	}
+
+	return solveTime, nil
+}
+
+func (device Device) RecommendTarget(
+	hash powValueTypes.Hash,
+	medianSolveTime time.Duration,
+) (powValueTypes.Target, error) {
+	successProbability, err := device.makeSuccessProbability(medianSolveTime)
+	if err != nil {
+		return powValueTypes.Target{}, fmt.Errorf(
+			"unable to make the success probability: %w",
+			err,
+		)
+	}
+
+	maxRawTarget := big.NewInt(0)
+	maxRawTarget.SetBit(maxRawTarget, hash.SizeInBits(), 1)
+
+	rawTarget, _ := new(big.Float).
+		Mul(
+			new(big.Float).SetInt(maxRawTarget),
+			big.NewFloat(successProbability),
+		).
+		Int(nil)
+	if rawTarget.Sign() == 0 {
+		return powValueTypes.Target{}, errors.New(
+			"median solve time is unreachable with the hash checksum size",
+		)
+	}
+
+	target, err := powValueTypes.NewTarget(rawTarget)
+	if err != nil {
+		return powValueTypes.Target{}, fmt.Errorf(
+			"unable to construct the target: %w",
+			err,
+		)
+	}
+
+	return target, nil
+}
+
+// the result is the leading zero bit count, the median solve time of which
+// is the closest one to the specified time on the logarithmic scale
+func (device Device) RecommendLeadingZeroBitCount(
+	hash powValueTypes.Hash,
+	medianSolveTime time.Duration,
+) (powValueTypes.LeadingZeroBitCount, error) {
+	successProbability, err := device.makeSuccessProbability(medianSolveTime)
+	if err != nil {
+		return powValueTypes.LeadingZeroBitCount{}, fmt.Errorf(
+			"unable to make the success probability: %w",
+			err,
+		)
+	}
+
+	rawLeadingZeroBitCount := int(math.Round(-math.Log2(successProbability)))
+	if rawLeadingZeroBitCount > hash.SizeInBits() {
+		return powValueTypes.LeadingZeroBitCount{}, errors.New(
+			"median solve time is unreachable with the hash checksum size",
+		)
+	}
+
+	leadingZeroBitCount, err :=
+		powValueTypes.NewLeadingZeroBitCount(rawLeadingZeroBitCount)
+	if err != nil {
+		return powValueTypes.LeadingZeroBitCount{}, fmt.Errorf(
+			"unable to construct the leading zero bit count: %w",
+			err,
+		)
+	}
+
+	return leadingZeroBitCount, nil
+}
+
+func (device Device) makeSolveTime(
+	attemptCount float64,
+) (time.Duration, error) {
+	solveTime := attemptCount / device.hashRate * float64(time.Second)
+	if solveTime >= math.MaxInt64 {
+		return 0, errors.New("solve time is too large to be represented")
+	}
+
+	return time.Duration(solveTime), nil
+}
+
+// the result is the probability of a single attempt to succeed,
+// with which the median attempt count corresponds to the specified time
+func (device Device) makeSuccessProbability(
+	medianSolveTime time.Duration,
+) (float64, error) {
+	if medianSolveTime <= 0 {
+		return 0, errors.New("median solve time must be positive")
+	}
+
+	medianAttemptCount := medianSolveTime.Seconds() * device.hashRate
+	if medianAttemptCount <= 1 {
+		return 1, nil
+	}
+
+	// (1 - p)^k = 1/2  =>  p = 1 - 2^(-1/k)
+	return -math.Expm1(-math.Ln2 / medianAttemptCount), nil
+}
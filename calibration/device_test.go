@@ -0,0 +1,453 @@
+package powCalibration
+
+import (
+	"crypto/sha256"
+	"hash/fnv"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+func TestNewDevice(test *testing.T) {
+	type args struct {
+		hashRate float64
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		want    Device
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				hashRate: 1024,
+			},
+			want: Device{
+				hashRate: 1024,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/zero",
+			args: args{
+				hashRate: 0,
+			},
+			want:    Device{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/negative",
+			args: args{
+				hashRate: -1024,
+			},
+			want:    Device{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/not finite",
+			args: args{
+				hashRate: math.NaN(),
+			},
+			want:    Device{},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err := NewDevice(data.args.hashRate)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestDevice_HashRate(test *testing.T) {
+	device := Device{
+		hashRate: 1024,
+	}
+	got := device.HashRate()
+
+	assert.Equal(test, float64(1024), got)
+}
+
+func TestDevice_ExpectedSolveTime(test *testing.T) {
+	type fields struct {
+		hashRate float64
+	}
+	type args struct {
+		hash   powValueTypes.Hash
+		target powValueTypes.Target
+	}
+
+	for _, data := range []struct {
+		name    string
+		fields  fields
+		args    args
+		want    time.Duration
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			fields: fields{
+				hashRate: 512,
+			},
+			args: args{
+				hash: powValueTypes.NewHashFromFactory(sha256.New),
+				target: func() powValueTypes.Target {
+					targetBitIndex, err := powValueTypes.NewTargetBitIndex(246)
+					require.NoError(test, err)
+
+					return powValueTypes.NewTargetFromBitIndex(targetBitIndex)
+				}(),
+			},
+			want:    2 * time.Second,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error",
+			fields: fields{
+				hashRate: 1,
+			},
+			args: args{
+				hash: powValueTypes.NewHashFromFactory(sha256.New),
+				target: func() powValueTypes.Target {
+					targetBitIndex, err := powValueTypes.NewTargetBitIndex(100)
+					require.NoError(test, err)
+
+					return powValueTypes.NewTargetFromBitIndex(targetBitIndex)
+				}(),
+			},
+			want:    0,
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			device := Device{
+				hashRate: data.fields.hashRate,
+			}
+			got, err := device.ExpectedSolveTime(data.args.hash, data.args.target)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestDevice_SolveTimePercentile(test *testing.T) {
+	type fields struct {
+		hashRate float64
+	}
+	type args struct {
+		hash       powValueTypes.Hash
+		target     powValueTypes.Target
+		percentile float64
+	}
+
+	for _, data := range []struct {
+		name    string
+		fields  fields
+		args    args
+		want    time.Duration
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			fields: fields{
+				hashRate: 2,
+			},
+			args: args{
+				hash: powValueTypes.NewHashFromFactory(sha256.New),
+				target: func() powValueTypes.Target {
+					targetBitIndex, err := powValueTypes.NewTargetBitIndex(255)
+					require.NoError(test, err)
+
+					return powValueTypes.NewTargetFromBitIndex(targetBitIndex)
+				}(),
+				percentile: 0.99,
+			},
+			want:    3500 * time.Millisecond,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/invalid percentile",
+			fields: fields{
+				hashRate: 2,
+			},
+			args: args{
+				hash: powValueTypes.NewHashFromFactory(sha256.New),
+				target: func() powValueTypes.Target {
+					targetBitIndex, err := powValueTypes.NewTargetBitIndex(255)
+					require.NoError(test, err)
+
+					return powValueTypes.NewTargetFromBitIndex(targetBitIndex)
+				}(),
+				percentile: 2,
+			},
+			want:    0,
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/too large solve time",
+			fields: fields{
+				hashRate: 1,
+			},
+			args: args{
+				hash: powValueTypes.NewHashFromFactory(sha256.New),
+				target: func() powValueTypes.Target {
+					targetBitIndex, err := powValueTypes.NewTargetBitIndex(100)
+					require.NoError(test, err)
+
+					return powValueTypes.NewTargetFromBitIndex(targetBitIndex)
+				}(),
+				percentile: 0.5,
+			},
+			want:    0,
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			device := Device{
+				hashRate: data.fields.hashRate,
+			}
+			got, err := device.SolveTimePercentile(
+				data.args.hash,
+				data.args.target,
+				data.args.percentile,
+			)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestDevice_RecommendTarget(test *testing.T) {
+	type fields struct {
+		hashRate float64
+	}
+	type args struct {
+		hash            powValueTypes.Hash
+		medianSolveTime time.Duration
+	}
+
+	for _, data := range []struct {
+		name                   string
+		fields                 fields
+		args                   args
+		wantMedianAttemptCount float64
+		wantErr                assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/regular solve time",
+			fields: fields{
+				hashRate: 726818,
+			},
+			args: args{
+				hash:            powValueTypes.NewHashFromFactory(sha256.New),
+				medianSolveTime: time.Second,
+			},
+			wantMedianAttemptCount: 726818,
+			wantErr:                assert.NoError,
+		},
+		{
+			name: "success/fractional difficulty",
+			fields: fields{
+				hashRate: 1000,
+			},
+			args: args{
+				hash:            powValueTypes.NewHashFromFactory(sha256.New),
+				medianSolveTime: 1500 * time.Millisecond,
+			},
+			wantMedianAttemptCount: 1500,
+			wantErr:                assert.NoError,
+		},
+		{
+			name: "success/short solve time",
+			fields: fields{
+				hashRate: 1,
+			},
+			args: args{
+				hash:            powValueTypes.NewHashFromFactory(sha256.New),
+				medianSolveTime: time.Millisecond,
+			},
+			wantMedianAttemptCount: 1,
+			wantErr:                assert.NoError,
+		},
+		{
+			name: "error/non-positive solve time",
+			fields: fields{
+				hashRate: 1,
+			},
+			args: args{
+				hash:            powValueTypes.NewHashFromFactory(sha256.New),
+				medianSolveTime: 0,
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/unreachable solve time",
+			fields: fields{
+				hashRate: 1e12,
+			},
+			args: args{
+				hash:            powValueTypes.NewHash(fnv.New32()),
+				medianSolveTime: 1000 * time.Hour,
+			},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			device := Device{
+				hashRate: data.fields.hashRate,
+			}
+			got, err := device.RecommendTarget(
+				data.args.hash,
+				data.args.medianSolveTime,
+			)
+
+			data.wantErr(test, err)
+			if err == nil {
+				medianAttemptCount, err :=
+					AttemptCountPercentile(data.args.hash, got, 0.5)
+				require.NoError(test, err)
+
+				assert.InDelta(
+					test,
+					data.wantMedianAttemptCount,
+					medianAttemptCount,
+					1,
+				)
+			}
+		})
+	}
+}
+
+func TestDevice_RecommendLeadingZeroBitCount(test *testing.T) {
+	type fields struct {
+		hashRate float64
+	}
+	type args struct {
+		hash            powValueTypes.Hash
+		medianSolveTime time.Duration
+	}
+
+	for _, data := range []struct {
+		name    string
+		fields  fields
+		args    args
+		want    powValueTypes.LeadingZeroBitCount
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/exact solve time",
+			fields: fields{
+				hashRate: 726818,
+			},
+			args: args{
+				hash:            powValueTypes.NewHashFromFactory(sha256.New),
+				medianSolveTime: time.Second,
+			},
+			want: func() powValueTypes.LeadingZeroBitCount {
+				value, err := powValueTypes.NewLeadingZeroBitCount(20)
+				require.NoError(test, err)
+
+				return value
+			}(),
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/rounded down",
+			fields: fields{
+				hashRate: 726818,
+			},
+			args: args{
+				hash:            powValueTypes.NewHashFromFactory(sha256.New),
+				medianSolveTime: 1400 * time.Millisecond,
+			},
+			want: func() powValueTypes.LeadingZeroBitCount {
+				value, err := powValueTypes.NewLeadingZeroBitCount(20)
+				require.NoError(test, err)
+
+				return value
+			}(),
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/rounded up",
+			fields: fields{
+				hashRate: 726818,
+			},
+			args: args{
+				hash:            powValueTypes.NewHashFromFactory(sha256.New),
+				medianSolveTime: 1500 * time.Millisecond,
+			},
+			want: func() powValueTypes.LeadingZeroBitCount {
+				value, err := powValueTypes.NewLeadingZeroBitCount(21)
+				require.NoError(test, err)
+
+				return value
+			}(),
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/short solve time",
+			fields: fields{
+				hashRate: 1,
+			},
+			args: args{
+				hash:            powValueTypes.NewHashFromFactory(sha256.New),
+				medianSolveTime: time.Millisecond,
+			},
+			want: func() powValueTypes.LeadingZeroBitCount {
+				value, err := powValueTypes.NewLeadingZeroBitCount(0)
+				require.NoError(test, err)
+
+				return value
+			}(),
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/non-positive solve time",
+			fields: fields{
+				hashRate: 1,
+			},
+			args: args{
+				hash:            powValueTypes.NewHashFromFactory(sha256.New),
+				medianSolveTime: -time.Second,
+			},
+			want:    powValueTypes.LeadingZeroBitCount{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/unreachable solve time",
+			fields: fields{
+				hashRate: 1e12,
+			},
+			args: args{
+				hash:            powValueTypes.NewHash(fnv.New32()),
+				medianSolveTime: 1000 * time.Hour,
+			},
+			want:    powValueTypes.LeadingZeroBitCount{},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			device := Device{
+				hashRate: data.fields.hashRate,
+			}
+			got, err := device.RecommendLeadingZeroBitCount(
+				data.args.hash,
+				data.args.medianSolveTime,
+			)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package powCalibration
+
+import (
+	"errors"
+	"math"
+
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+func SuccessProbability(
+	hash powValueTypes.Hash,
+	target powValueTypes.Target,
+) float64 {
+	return target.SuccessProbability(hash.SizeInBits())
+}
+
+func ExpectedAttemptCount(
+	hash powValueTypes.Hash,
+	target powValueTypes.Target,
+) float64 {
+	return target.ExpectedAttemptCount(hash.SizeInBits())
+}
+
+// the attempt count until the first success has the geometric distribution,
+// so the percentile is the minimal attempt count, with which the challenge
+// is solved with the specified probability
+func AttemptCountPercentile(
+	hash powValueTypes.Hash,
+	target powValueTypes.Target,
+	percentile float64,
+) (float64, error) {
+	if !(percentile > 0 && percentile < 1) {
+		return 0, errors.New("percentile must be between zero and one exclusively")
+	}
+
+	successProbability := SuccessProbability(hash, target)
+	if successProbability == 1 {
+		return 1, nil
+	}
+
+	// 1 - (1 - p)^k >= q  =>  k >= ln(1 - q) / ln(1 - p)
+	attemptCount :=
+		math.Ceil(math.Log1p(-percentile) / math.Log1p(-successProbability))
+	return max(attemptCount, 1), nil
+}
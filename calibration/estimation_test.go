@@ -0,0 +1,235 @@
+package powCalibration
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+func TestSuccessProbability(test *testing.T) {
+	type args struct {
+		hash   powValueTypes.Hash
+		target powValueTypes.Target
+	}
+
+	for _, data := range []struct {
+		name string
+		args args
+		want float64
+	}{
+		{
+			name: "success/power of two",
+			args: args{
+				hash: powValueTypes.NewHashFromFactory(sha256.New),
+				target: func() powValueTypes.Target {
+					targetBitIndex, err := powValueTypes.NewTargetBitIndex(246)
+					require.NoError(test, err)
+
+					return powValueTypes.NewTargetFromBitIndex(targetBitIndex)
+				}(),
+			},
+			want: 1.0 / 1024,
+		},
+		{
+			name: "success/arbitrary target",
+			args: args{
+				hash: powValueTypes.NewHashFromFactory(sha256.New),
+				target: func() powValueTypes.Target {
+					value, err := powValueTypes.NewTarget(
+						big.NewInt(0).Lsh(big.NewInt(3), 245),
+					)
+					require.NoError(test, err)
+
+					return value
+				}(),
+			},
+			want: 3.0 / 2048,
+		},
+		{
+			name: "success/maximal target",
+			args: args{
+				hash: powValueTypes.NewHashFromFactory(sha256.New),
+				target: func() powValueTypes.Target {
+					targetBitIndex, err := powValueTypes.NewTargetBitIndex(256)
+					require.NoError(test, err)
+
+					return powValueTypes.NewTargetFromBitIndex(targetBitIndex)
+				}(),
+			},
+			want: 1,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got := SuccessProbability(data.args.hash, data.args.target)
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
+func TestExpectedAttemptCount(test *testing.T) {
+	type args struct {
+		hash   powValueTypes.Hash
+		target powValueTypes.Target
+	}
+
+	for _, data := range []struct {
+		name string
+		args args
+		want float64
+	}{
+		{
+			name: "success/power of two",
+			args: args{
+				hash: powValueTypes.NewHashFromFactory(sha256.New),
+				target: func() powValueTypes.Target {
+					targetBitIndex, err := powValueTypes.NewTargetBitIndex(246)
+					require.NoError(test, err)
+
+					return powValueTypes.NewTargetFromBitIndex(targetBitIndex)
+				}(),
+			},
+			want: 1024,
+		},
+		{
+			name: "success/maximal target",
+			args: args{
+				hash: powValueTypes.NewHashFromFactory(sha256.New),
+				target: func() powValueTypes.Target {
+					targetBitIndex, err := powValueTypes.NewTargetBitIndex(256)
+					require.NoError(test, err)
+
+					return powValueTypes.NewTargetFromBitIndex(targetBitIndex)
+				}(),
+			},
+			want: 1,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got := ExpectedAttemptCount(data.args.hash, data.args.target)
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
+func TestAttemptCountPercentile(test *testing.T) {
+	type args struct {
+		hash       powValueTypes.Hash
+		target     powValueTypes.Target
+		percentile float64
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		want    float64
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/median",
+			args: args{
+				hash: powValueTypes.NewHashFromFactory(sha256.New),
+				target: func() powValueTypes.Target {
+					targetBitIndex, err := powValueTypes.NewTargetBitIndex(255)
+					require.NoError(test, err)
+
+					return powValueTypes.NewTargetFromBitIndex(targetBitIndex)
+				}(),
+				percentile: 0.5,
+			},
+			want:    1,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/high percentile",
+			args: args{
+				hash: powValueTypes.NewHashFromFactory(sha256.New),
+				target: func() powValueTypes.Target {
+					targetBitIndex, err := powValueTypes.NewTargetBitIndex(255)
+					require.NoError(test, err)
+
+					return powValueTypes.NewTargetFromBitIndex(targetBitIndex)
+				}(),
+				percentile: 0.99,
+			},
+			want:    7, // 1 - 2^(-7) >= 0.99 > 1 - 2^(-6)
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/median of a hard challenge",
+			args: args{
+				hash: powValueTypes.NewHashFromFactory(sha256.New),
+				target: func() powValueTypes.Target {
+					targetBitIndex, err := powValueTypes.NewTargetBitIndex(236)
+					require.NoError(test, err)
+
+					return powValueTypes.NewTargetFromBitIndex(targetBitIndex)
+				}(),
+				percentile: 0.5,
+			},
+			want:    726818, // ceil(ln(1/2) / ln(1 - 2^(-20)))
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/maximal target",
+			args: args{
+				hash: powValueTypes.NewHashFromFactory(sha256.New),
+				target: func() powValueTypes.Target {
+					targetBitIndex, err := powValueTypes.NewTargetBitIndex(256)
+					require.NoError(test, err)
+
+					return powValueTypes.NewTargetFromBitIndex(targetBitIndex)
+				}(),
+				percentile: 0.99,
+			},
+			want:    1,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/zero percentile",
+			args: args{
+				hash: powValueTypes.NewHashFromFactory(sha256.New),
+				target: func() powValueTypes.Target {
+					targetBitIndex, err := powValueTypes.NewTargetBitIndex(255)
+					require.NoError(test, err)
+
+					return powValueTypes.NewTargetFromBitIndex(targetBitIndex)
+				}(),
+				percentile: 0,
+			},
+			want:    0,
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/unit percentile",
+			args: args{
+				hash: powValueTypes.NewHashFromFactory(sha256.New),
+				target: func() powValueTypes.Target {
+					targetBitIndex, err := powValueTypes.NewTargetBitIndex(255)
+					require.NoError(test, err)
+
+					return powValueTypes.NewTargetFromBitIndex(targetBitIndex)
+				}(),
+				percentile: 1,
+			},
+			want:    0,
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err := AttemptCountPercentile(
+				data.args.hash,
+				data.args.target,
+				data.args.percentile,
+			)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
@@ -4,10 +4,15 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"hash"
+	"math/big"
+	"sync/atomic"
 	"time"
 
 	"github.com/samber/mo"
 	powErrors "github.com/thewizardplusplus/go-pow/errors"
+	powHooks "github.com/thewizardplusplus/go-pow/internal/hooks"
+	powRawChallenge "github.com/thewizardplusplus/go-pow/internal/raw-challenge"
 	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
 )
 
@@ -16,14 +21,49 @@ type ChallengeHashData struct {
 	Nonce     powValueTypes.Nonce
 }
 
+func (data ChallengeHashData) ToBinaryHashData() (
+	powValueTypes.BinaryHashData,
+	error,
+) {
+	target, err := data.Challenge.Target()
+	if err != nil {
+		return powValueTypes.BinaryHashData{}, fmt.Errorf(
+			"unable to get the target: %w",
+			err,
+		)
+	}
+
+	binaryData := powValueTypes.BinaryHashData{
+		LeadingZeroBitCount: data.Challenge.leadingZeroBitCount,
+		Target:              target,
+		CreatedAt:           data.Challenge.createdAt,
+		TTL:                 data.Challenge.ttl,
+		Resource:            data.Challenge.resource,
+		SerializedPayload:   data.Challenge.serializedPayload,
+		Nonce:               data.Nonce,
+	}
+	return binaryData, nil
+}
+
+func init() {
+	powHooks.RegisterUnmarshalRawSolutionChallenge(
+		unmarshalRawSolutionChallenge,
+	)
+}
+
 type Challenge struct {
 	leadingZeroBitCount powValueTypes.LeadingZeroBitCount
+	arbitraryTarget     mo.Option[powValueTypes.Target]
 	createdAt           mo.Option[powValueTypes.CreatedAt]
 	ttl                 mo.Option[powValueTypes.TTL]
 	resource            mo.Option[powValueTypes.Resource]
 	serializedPayload   powValueTypes.SerializedPayload
 	hash                powValueTypes.Hash
 	hashDataLayout      powValueTypes.HashDataLayout
+	nonceEncoding       mo.Option[powValueTypes.NonceEncoding]
+	signature           mo.Option[powValueTypes.Signature]
+
+	requiredHashDataFields mo.Option[[]powValueTypes.HashDataField]
 }
 
 func (entity Challenge) LeadingZeroBitCount() powValueTypes.LeadingZeroBitCount { //nolint:lll
@@ -44,6 +84,26 @@ func (entity Challenge) TargetBitIndex() (powValueTypes.TargetBitIndex, error) {
 	return value, nil
 }
 
+func (entity Challenge) ArbitraryTarget() mo.Option[powValueTypes.Target] {
+	return entity.arbitraryTarget
+}
+
+func (entity Challenge) Target() (powValueTypes.Target, error) {
+	if arbitraryTarget, isPresent := entity.arbitraryTarget.Get(); isPresent {
+		return arbitraryTarget, nil
+	}
+
+	targetBitIndex, err := entity.TargetBitIndex()
+	if err != nil {
+		return powValueTypes.Target{}, fmt.Errorf(
+			"unable to get the target bit index: %w",
+			err,
+		)
+	}
+
+	return powValueTypes.NewTargetFromBitIndex(targetBitIndex), nil
+}
+
 func (entity Challenge) CreatedAt() mo.Option[powValueTypes.CreatedAt] {
 	return entity.createdAt
 }
@@ -53,11 +113,34 @@ func (entity Challenge) TTL() mo.Option[powValueTypes.TTL] {
 }
 
 func (entity Challenge) IsAlive() bool {
+	return entity.IsAliveAt(time.Now())
+}
+
+func (entity Challenge) IsAliveAt(moment time.Time) bool {
 	createdAt, isCreatedAtPresent := entity.createdAt.Get()
 	ttl, isTTLPresent := entity.ttl.Get()
 	return !isCreatedAtPresent ||
 		!isTTLPresent ||
-		time.Since(createdAt.ToTime()) <= ttl.ToDuration()
+		moment.Sub(createdAt.ToTime()) <= ttl.ToDuration()
+}
+
+type LivenessParams struct {
+	Clock              mo.Option[Clock]
+	ClockSkewTolerance mo.Option[time.Duration]
+}
+
+// unlike `IsAlive()` and `IsAliveAt()`, it accepts a `CreatedAt` timestamp
+// in the future only within the tolerance for clock skew
+func (entity Challenge) IsAliveWithParams(params LivenessParams) bool {
+	moment := params.Clock.OrElse(SystemClock{}).Now()
+	if createdAt, isCreatedAtPresent := entity.createdAt.Get(); isCreatedAtPresent {
+		clockSkewTolerance := params.ClockSkewTolerance.OrElse(0)
+		if createdAt.ToTime().After(moment.Add(clockSkewTolerance)) {
+			return false
+		}
+	}
+
+	return entity.IsAliveAt(moment)
 }
 
 func (entity Challenge) Resource() mo.Option[powValueTypes.Resource] {
@@ -76,22 +159,284 @@ func (entity Challenge) HashDataLayout() powValueTypes.HashDataLayout {
 	return entity.hashDataLayout
 }
 
+func (entity Challenge) NonceEncoding() mo.Option[powValueTypes.NonceEncoding] { //nolint:lll
+	return entity.nonceEncoding
+}
+
+func (entity Challenge) Signature() mo.Option[powValueTypes.Signature] {
+	return entity.signature
+}
+
+// the fields are stored along with the challenge, so the rebuilt challenge
+// (for example, after the JSON round trip) is checked in the same way
+func (entity Challenge) RequiredHashDataFields() mo.Option[[]powValueTypes.HashDataField] { //nolint:lll
+	return entity.requiredHashDataFields
+}
+
+func (entity Challenge) WithSignature(
+	signature powValueTypes.Signature,
+) Challenge {
+	entity.signature = mo.Some(signature)
+	return entity
+}
+
+// the canonical data is written with the same fields as on serialization,
+// so a challenge and its serialized form have the same canonical data;
+// the signature itself isn't included
+func (entity Challenge) WriteCanonicalData(writer hash.Hash) {
+	powRawChallenge.WriteCanonicalData(writer, newRawChallenge(entity))
+}
+
+// the nonce is rendered with the encoding of the challenge,
+// so the solver and the verifier always agree on it
+func (entity Challenge) newHashData(
+	nonce powValueTypes.Nonce,
+) ChallengeHashData {
+	if nonceEncoding, isPresent := entity.nonceEncoding.Get(); isPresent {
+		nonce = nonce.WithEncoding(nonceEncoding)
+	}
+
+	return ChallengeHashData{
+		Challenge: entity,
+		Nonce:     nonce,
+	}
+}
+
 type SolveParams struct {
 	MaxAttemptCount          mo.Option[int]
 	RandomInitialNonceParams mo.Option[powValueTypes.RandomNonceParams]
+	ConcurrencyFactor        mo.Option[int]
+	ProgressParams           mo.Option[ProgressParams]
+	Checkpoint               mo.Option[SolveCheckpoint]
+	NonceRange               mo.Option[powValueTypes.NonceRange]
 }
 
 func (entity Challenge) Solve(
 	ctx context.Context,
 	params SolveParams,
 ) (Solution, error) {
+	if progressParams, isPresent := params.ProgressParams.Get(); isPresent {
+		if err := progressParams.validate(); err != nil {
+			return Solution{}, fmt.Errorf(
+				"unable to validate the progress parameters: %w",
+				err,
+			)
+		}
+	}
+
+	workerInitialNonces, err := makeWorkerInitialNonces(params)
+	if err != nil {
+		return Solution{}, fmt.Errorf(
+			"unable to make the initial nonces for the workers: %w",
+			err,
+		)
+	}
+
+	workerHashes, err := entity.makeWorkerHashes(len(workerInitialNonces))
+	if err != nil {
+		return Solution{}, fmt.Errorf(
+			"unable to make the hashes for the workers: %w",
+			err,
+		)
+	}
+
+	target, err := entity.Target()
+	if err != nil {
+		return Solution{}, fmt.Errorf("unable to get the target: %w", err)
+	}
+
+	// prepare all the workers in advance, so that an error doesn't leave
+	// the already started ones running
+	concurrencyFactor := len(workerInitialNonces)
+	workerParamsGroup := make([]solvingWorkerParams, 0, concurrencyFactor)
+	nonceStep := big.NewInt(int64(concurrencyFactor))
+	attemptCounter := &atomic.Int64{}
+	precompiledLayout := entity.precompileHashDataLayout()
+	reporter := mapOption(
+		params.ProgressParams,
+		func(progressParams ProgressParams) *progressReporter {
+			return newProgressReporter(
+				progressParams,
+				target.ExpectedAttemptCount(entity.hash.SizeInBits()),
+			)
+		},
+	)
+	for workerIndex, workerInitialNonce := range workerInitialNonces {
+		workerHash := workerHashes[workerIndex]
+		workerParamsGroup = append(workerParamsGroup, solvingWorkerParams{
+			workerIndex:     workerIndex,
+			initialNonce:    workerInitialNonce,
+			nonceStep:       nonceStep,
+			target:          target,
+			attemptCounter:  attemptCounter,
+			maxAttemptCount: params.MaxAttemptCount,
+			nonceRange:      params.NonceRange,
+			hash:            workerHash,
+			precompiledLayout: mapOption(
+				precompiledLayout,
+				func(layout precompiledHashDataLayout) precompiledHashDataLayout {
+					return layout.withHash(workerHash)
+				},
+			),
+			progressReporter: reporter,
+		})
+	}
+
+	workerCtx, workerCtxCancel := context.WithCancel(ctx)
+	defer workerCtxCancel()
+
+	if reporter, isPresent := reporter.Get(); isPresent {
+		reporter.start(workerCtx)
+	}
+
+	resultChannel := make(chan solvingWorkerResult, concurrencyFactor)
+	for _, workerParams := range workerParamsGroup {
+		go func() {
+			resultChannel <- entity.runSolvingWorker(workerCtx, workerParams)
+		}()
+	}
+
+	var foundResult mo.Option[solvingWorkerResult]
+	var firstErr error
+	isInterrupted := true
+	checkpoint := SolveCheckpoint{
+		WorkerNextNonces: make([]powValueTypes.Nonce, concurrencyFactor),
+		AttemptCount:     params.Checkpoint.OrEmpty().AttemptCount,
+	}
+	var exhaustionErr error
+	for range concurrencyFactor {
+		result := <-resultChannel
+
+		checkpoint.WorkerNextNonces[result.workerIndex] = result.nextNonce
+		checkpoint.AttemptCount += result.attemptCount
+
+		// the other workers may still have nonces in their parts of the range,
+		// so they aren't stopped
+		if errors.Is(result.err, powErrors.ErrNonceRangeExhausted) {
+			if exhaustionErr == nil {
+				exhaustionErr = result.err
+			}
+
+			continue
+		}
+
+		// the first completed worker stops all the others regardless of whether
+		// it has found a solution or failed
+		workerCtxCancel()
+
+		if result.err != nil {
+			if firstErr == nil {
+				firstErr = result.err
+			}
+			if !errors.Is(result.err, powErrors.ErrTaskInterruption) {
+				isInterrupted = false
+			}
+
+			continue
+		}
+		if foundResult.IsAbsent() {
+			foundResult = mo.Some(result)
+		}
+	}
+
+	result, isFound := foundResult.Get()
+	if !isFound {
+		if firstErr == nil {
+			return Solution{}, exhaustionErr
+		}
+		if isInterrupted {
+			return Solution{}, InterruptionError{
+				Checkpoint: checkpoint,
+				err:        firstErr,
+			}
+		}
+
+		return Solution{}, firstErr
+	}
+
+	solution, err := NewSolutionBuilder().
+		SetChallenge(entity).
+		SetNonce(result.nextNonce).
+		SetHashSum(result.hashSum).
+		Build()
+	if err != nil {
+		return Solution{}, fmt.Errorf("unable to build the solution: %w", err)
+	}
+
+	return solution, nil
+}
+
+// each worker needs its own instance of the hash, otherwise the workers
+// would take turns using the single one without any speedup; a single worker
+// uses the hash as is, so the hash has to be cloneable only for several ones
+func (entity Challenge) makeWorkerHashes(
+	workerCount int,
+) ([]powValueTypes.Hash, error) {
+	if workerCount == 1 {
+		return []powValueTypes.Hash{entity.hash}, nil
+	}
+
+	workerHashes := make([]powValueTypes.Hash, 0, workerCount)
+	for range workerCount {
+		workerHash, err := entity.hash.Clone()
+		if err != nil {
+			return nil, fmt.Errorf("unable to clone the hash: %w", err)
+		}
+
+		workerHashes = append(workerHashes, workerHash)
+	}
+
+	return workerHashes, nil
+}
+
+func makeWorkerInitialNonces(
+	params SolveParams,
+) ([]powValueTypes.Nonce, error) {
+	concurrencyFactor, isConcurrencyFactorPresent :=
+		params.ConcurrencyFactor.Get()
+	if isConcurrencyFactorPresent && concurrencyFactor < 1 {
+		return nil, errors.New("concurrency factor must be positive")
+	}
+
+	if checkpoint, isPresent := params.Checkpoint.Get(); isPresent {
+		if err := checkpoint.validate(); err != nil {
+			return nil, fmt.Errorf("unable to validate the checkpoint: %w", err)
+		}
+
+		// the nonce space is partitioned according to the concurrency factor,
+		// so it cannot be changed on resuming
+		if isConcurrencyFactorPresent &&
+			concurrencyFactor != len(checkpoint.WorkerNextNonces) {
+			return nil, errors.New(
+				"concurrency factor doesn't match the checkpoint",
+			)
+		}
+		if params.RandomInitialNonceParams.IsPresent() {
+			return nil, errors.New(
+				"random initial nonce and checkpoint " +
+					"are specified at the same time",
+			)
+		}
+
+		return checkpoint.WorkerNextNonces, nil
+	}
+
 	var nonce powValueTypes.Nonce
-	if randomInitialNonceParams, isPresent :=
+	if nonceRange, isPresent := params.NonceRange.Get(); isPresent {
+		if params.RandomInitialNonceParams.IsPresent() {
+			return nil, errors.New(
+				"random initial nonce and nonce range " +
+					"are specified at the same time",
+			)
+		}
+
+		nonce = nonceRange.Start()
+	} else if randomInitialNonceParams, isPresent :=
 		params.RandomInitialNonceParams.Get(); isPresent {
 		var err error
 		nonce, err = powValueTypes.NewRandomNonce(randomInitialNonceParams)
 		if err != nil {
-			return Solution{}, fmt.Errorf(
+			return nil, fmt.Errorf(
 				"unable to generate the random initial nonce: %w",
 				err,
 			)
@@ -100,69 +445,153 @@ func (entity Challenge) Solve(
 		var err error
 		nonce, err = powValueTypes.NewZeroNonce()
 		if err != nil {
-			return Solution{}, fmt.Errorf(
+			return nil, fmt.Errorf(
 				"unable to construct the zero initial nonce: %w",
 				err,
 			)
 		}
 	}
 
-	targetBitIndex, err := entity.TargetBitIndex()
-	if err != nil {
-		return Solution{}, fmt.Errorf("unable to get the target bit index: %w", err)
+	if !isConcurrencyFactorPresent {
+		concurrencyFactor = 1
+	}
+
+	workerInitialNonces := make([]powValueTypes.Nonce, 0, concurrencyFactor)
+	for workerIndex := range concurrencyFactor {
+		workerInitialNonce, err :=
+			nonce.IncrementedBy(big.NewInt(int64(workerIndex)))
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to shift the initial nonce for the worker: %w",
+				err,
+			)
+		}
+
+		workerInitialNonces = append(workerInitialNonces, workerInitialNonce)
 	}
 
-	var hashSum powValueTypes.HashSum
-	target := makeTarget(targetBitIndex)
-	maxAttemptCount, isMaxAttemptCountPresent := params.MaxAttemptCount.Get()
-	for attemptIndex := 0; ; attemptIndex++ {
+	return workerInitialNonces, nil
+}
+
+type solvingWorkerParams struct {
+	workerIndex       int
+	initialNonce      powValueTypes.Nonce
+	nonceStep         *big.Int
+	target            powValueTypes.Target
+	attemptCounter    *atomic.Int64
+	maxAttemptCount   mo.Option[int]
+	nonceRange        mo.Option[powValueTypes.NonceRange]
+	hash              powValueTypes.Hash
+	precompiledLayout mo.Option[precompiledHashDataLayout]
+	progressReporter  mo.Option[*progressReporter]
+}
+
+// on success, the next nonce is the found one; otherwise, it's the first
+// nonce that hasn't been tried yet
+type solvingWorkerResult struct {
+	workerIndex  int
+	nextNonce    powValueTypes.Nonce
+	attemptCount int
+	hashSum      powValueTypes.HashSum
+	err          error
+}
+
+func (entity Challenge) runSolvingWorker(
+	ctx context.Context,
+	params solvingWorkerParams,
+) solvingWorkerResult {
+	nonce := params.initialNonce
+	workerAttemptCount := 0
+	maxAttemptCount, isMaxAttemptCountPresent := params.maxAttemptCount.Get()
+	nonceRange, isNonceRangePresent := params.nonceRange.Get()
+	precompiledLayout, isLayoutPrecompiled := params.precompiledLayout.Get()
+	reporter, isReporterPresent := params.progressReporter.Get()
+	for {
 		select {
 		case <-ctx.Done():
-			return Solution{}, fmt.Errorf(
-				"context is done: %w",
-				errors.Join(ctx.Err(), powErrors.ErrTaskInterruption),
-			)
+			return solvingWorkerResult{
+				workerIndex:  params.workerIndex,
+				nextNonce:    nonce,
+				attemptCount: workerAttemptCount,
+				err: fmt.Errorf(
+					"context is done: %w",
+					errors.Join(ctx.Err(), powErrors.ErrTaskInterruption),
+				),
+			}
 
 		default:
 		}
 
-		if isMaxAttemptCountPresent && attemptIndex >= maxAttemptCount {
-			return Solution{}, errors.Join(
-				errors.New("maximal attempt count is exceeded"),
-				powErrors.ErrTaskInterruption,
-			)
+		if isNonceRangePresent &&
+			nonce.ToBigInt().Cmp(nonceRange.End().ToBigInt()) >= 0 {
+			return solvingWorkerResult{
+				workerIndex:  params.workerIndex,
+				nextNonce:    nonce,
+				attemptCount: workerAttemptCount,
+				err: errors.Join(
+					errors.New("nonce range is exhausted"),
+					powErrors.ErrNonceRangeExhausted,
+				),
+			}
 		}
 
-		hashData, err := entity.hashDataLayout.Execute(ChallengeHashData{
-			Challenge: entity,
-			Nonce:     nonce,
-		})
-		if err != nil {
-			return Solution{}, fmt.Errorf(
-				"unable to execute the hash data layout: %w",
-				err,
-			)
+		// the attempt counter is shared between all the workers,
+		// so the maximal attempt count limits them in total
+		attemptCount := params.attemptCounter.Add(1)
+		if isMaxAttemptCountPresent && attemptCount > int64(maxAttemptCount) {
+			return solvingWorkerResult{
+				workerIndex:  params.workerIndex,
+				nextNonce:    nonce,
+				attemptCount: workerAttemptCount,
+				err: errors.Join(
+					errors.New("maximal attempt count is exceeded"),
+					powErrors.ErrTaskInterruption,
+				),
+			}
 		}
 
-		hashSum = entity.hash.ApplyTo(hashData)
-		if isHashSumFitTarget(hashSum, target) {
-			break
+		var hashSum powValueTypes.HashSum
+		if isLayoutPrecompiled {
+			hashSum = precompiledLayout.applyHashTo(nonce)
+		} else {
+			hashData, err := entity.hashDataLayout.Execute(entity.newHashData(nonce))
+			if err != nil {
+				return solvingWorkerResult{
+					workerIndex:  params.workerIndex,
+					nextNonce:    nonce,
+					attemptCount: workerAttemptCount,
+					err: fmt.Errorf(
+						"unable to execute the hash data layout: %w",
+						err,
+					),
+				}
+			}
+
+			hashSum = params.hash.ApplyTo(hashData)
+		}
+		workerAttemptCount++
+		if isReporterPresent {
+			reporter.onAttempt(attemptCount, nonce)
+		}
+		if isHashSumFitTarget(hashSum, params.target) {
+			return solvingWorkerResult{
+				workerIndex:  params.workerIndex,
+				nextNonce:    nonce,
+				attemptCount: workerAttemptCount,
+				hashSum:      hashSum,
+			}
 		}
 
-		nonce, err = nonce.Incremented()
+		nextNonce, err := nonce.IncrementedBy(params.nonceStep)
 		if err != nil {
-			return Solution{}, fmt.Errorf("unable to increment the nonce: %w", err)
+			return solvingWorkerResult{
+				workerIndex:  params.workerIndex,
+				nextNonce:    nonce,
+				attemptCount: workerAttemptCount,
+				err:          fmt.Errorf("unable to increment the nonce: %w", err),
+			}
 		}
-	}
 
-	solution, err := NewSolutionBuilder().
-		SetChallenge(entity).
-		SetNonce(nonce).
-		SetHashSum(hashSum).
-		Build()
-	if err != nil {
-		return Solution{}, fmt.Errorf("unable to build the solution: %w", err)
+		nonce = nextNonce
 	}
-
-	return solution, nil
 }
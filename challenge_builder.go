@@ -3,20 +3,35 @@ package pow
 import (
 	"errors"
 	"fmt"
+	"math/big"
 
 	"github.com/samber/mo"
 	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
 )
 
+var (
+	// layouts that don't refer to the nonce give the same hash input
+	// on each attempt, so the solving never ends
+	DefaultRequiredHashDataFields = []powValueTypes.HashDataField{
+		powValueTypes.HashDataFieldNonce,
+	}
+)
+
 type ChallengeBuilder struct {
 	leadingZeroBitCount mo.Option[powValueTypes.LeadingZeroBitCount]
 	targetBitIndex      mo.Option[powValueTypes.TargetBitIndex]
+	target              mo.Option[powValueTypes.Target]
 	createdAt           mo.Option[powValueTypes.CreatedAt]
 	ttl                 mo.Option[powValueTypes.TTL]
 	resource            mo.Option[powValueTypes.Resource]
 	serializedPayload   mo.Option[powValueTypes.SerializedPayload]
 	hash                mo.Option[powValueTypes.Hash]
 	hashDataLayout      mo.Option[powValueTypes.HashDataLayout]
+	hashDataLayoutName  mo.Option[string]
+	nonceEncoding       mo.Option[powValueTypes.NonceEncoding]
+	signature           mo.Option[powValueTypes.Signature]
+
+	requiredHashDataFields mo.Option[[]powValueTypes.HashDataField]
 }
 
 func NewChallengeBuilder() *ChallengeBuilder {
@@ -37,6 +52,13 @@ func (builder *ChallengeBuilder) SetTargetBitIndex(
 	return builder
 }
 
+func (builder *ChallengeBuilder) SetTarget(
+	value powValueTypes.Target,
+) *ChallengeBuilder {
+	builder.target = mo.Some(value)
+	return builder
+}
+
 func (builder *ChallengeBuilder) SetCreatedAt(
 	value powValueTypes.CreatedAt,
 ) *ChallengeBuilder {
@@ -79,23 +101,66 @@ func (builder *ChallengeBuilder) SetHashDataLayout(
 	return builder
 }
 
+// the name refers to one of the predefined layouts
+func (builder *ChallengeBuilder) SetHashDataLayoutByName(
+	name string,
+) *ChallengeBuilder {
+	builder.hashDataLayoutName = mo.Some(name)
+	return builder
+}
+
+func (builder *ChallengeBuilder) SetNonceEncoding(
+	value powValueTypes.NonceEncoding,
+) *ChallengeBuilder {
+	builder.nonceEncoding = mo.Some(value)
+	return builder
+}
+
+func (builder *ChallengeBuilder) SetSignature(
+	value powValueTypes.Signature,
+) *ChallengeBuilder {
+	builder.signature = mo.Some(value)
+	return builder
+}
+
+// the fields are checked statically, i.e. without executing the layout
+func (builder *ChallengeBuilder) SetRequiredHashDataFields(
+	values []powValueTypes.HashDataField,
+) *ChallengeBuilder {
+	builder.requiredHashDataFields = mo.Some(values)
+	return builder
+}
+
 func (builder ChallengeBuilder) Build() (Challenge, error) {
 	var errs []error
 
 	leadingZeroBitCount, isLeadingZeroBitCountPresent :=
 		builder.leadingZeroBitCount.Get()
 	targetBitIndex, isTargetBitIndexPresent := builder.targetBitIndex.Get()
-	if !isLeadingZeroBitCountPresent && !isTargetBitIndexPresent {
+	target, isTargetPresent := builder.target.Get()
+	difficultyParameterCount := 0
+	for _, isPresent := range []bool{
+		isLeadingZeroBitCountPresent,
+		isTargetBitIndexPresent,
+		isTargetPresent,
+	} {
+		if isPresent {
+			difficultyParameterCount++
+		}
+	}
+	if difficultyParameterCount == 0 {
 		errs = append(
 			errs,
-			errors.New("leading zero bit count or target bit index is required"),
+			errors.New(
+				"leading zero bit count, target bit index or target is required",
+			),
 		)
-	} else if isLeadingZeroBitCountPresent && isTargetBitIndexPresent {
+	} else if difficultyParameterCount > 1 {
 		errs = append(
 			errs,
 			errors.New(
-				"leading zero bit count and target bit index "+
-					"are specified at the same time",
+				"only one of leading zero bit count, target bit index and target "+
+					"should be specified",
 			),
 		)
 	}
@@ -137,10 +202,51 @@ func (builder ChallengeBuilder) Build() (Challenge, error) {
 				fmt.Errorf("unable to construct the leading zero bit count: %w", err),
 			)
 		}
+	} else if isTargetPresent {
+		maxRawTarget := big.NewInt(0)
+		maxRawTarget.SetBit(maxRawTarget, hash.SizeInBits(), 1)
+		if target.ToBigInt().Cmp(maxRawTarget) == 1 {
+			errs = append(
+				errs,
+				errors.New("target exceeds the hash checksum range"),
+			)
+		} else {
+			var err error
+			leadingZeroBitCount, err =
+				target.LeadingZeroBitCount(hash.SizeInBits())
+			if err != nil {
+				errs = append(
+					errs,
+					fmt.Errorf(
+						"unable to get the leading zero bit count of the target: %w",
+						err,
+					),
+				)
+			}
+		}
 	}
 
-	hashDataLayout, isPresent := builder.hashDataLayout.Get()
-	if !isPresent {
+	hashDataLayout, isHashDataLayoutPresent := builder.hashDataLayout.Get()
+	hashDataLayoutName, isHashDataLayoutNamePresent :=
+		builder.hashDataLayoutName.Get()
+	if isHashDataLayoutPresent && isHashDataLayoutNamePresent {
+		errs = append(
+			errs,
+			errors.New(
+				"only one of hash data layout and its name should be specified",
+			),
+		)
+	} else if isHashDataLayoutNamePresent {
+		var err error
+		hashDataLayout, err =
+			powValueTypes.LookupPredefinedHashDataLayout(hashDataLayoutName)
+		if err != nil {
+			errs = append(
+				errs,
+				fmt.Errorf("unable to look up the hash data layout: %w", err),
+			)
+		}
+	} else if !isHashDataLayoutPresent {
 		errs = append(errs, errors.New("hash data layout is required"))
 	}
 
@@ -150,14 +256,19 @@ func (builder ChallengeBuilder) Build() (Challenge, error) {
 
 	entity := Challenge{
 		leadingZeroBitCount: leadingZeroBitCount,
+		arbitraryTarget:     builder.target,
 		createdAt:           builder.createdAt,
 		ttl:                 builder.ttl,
 		resource:            builder.resource,
 		serializedPayload:   serializedPayload,
 		hash:                hash,
 		hashDataLayout:      hashDataLayout,
+		nonceEncoding:       builder.nonceEncoding,
+		signature:           builder.signature,
+
+		requiredHashDataFields: builder.requiredHashDataFields,
 	}
-	if err := builder.checkHashDataLayout(entity); err != nil {
+	if err := entity.checkHashDataLayout(); err != nil {
 		return Challenge{}, fmt.Errorf(
 			"unable to check the hash data layout: %w",
 			err,
@@ -167,16 +278,28 @@ func (builder ChallengeBuilder) Build() (Challenge, error) {
 	return entity, nil
 }
 
-func (builder ChallengeBuilder) checkHashDataLayout(entity Challenge) error {
+func (entity Challenge) checkHashDataLayout() error {
+	// binary layouts write the nonce as fixed-width bytes,
+	// so the nonce encoding would be silently ignored
+	if entity.hashDataLayout.Kind() == powValueTypes.HashDataLayoutKindBinary &&
+		entity.nonceEncoding.IsPresent() {
+		return errors.New("binary layout doesn't accept a nonce encoding")
+	}
+
+	if err := entity.hashDataLayout.CheckRequiredFields(
+		entity.requiredHashDataFields.OrElse(DefaultRequiredHashDataFields),
+	); err != nil {
+		return fmt.Errorf("unable to check the required fields: %w", err)
+	}
+
 	nonce, err := powValueTypes.NewZeroNonce()
 	if err != nil {
 		return fmt.Errorf("unable to construct the zero nonce: %w", err)
 	}
 
-	if _, err := entity.hashDataLayout.Execute(ChallengeHashData{
-		Challenge: entity,
-		Nonce:     nonce,
-	}); err != nil {
+	if _, err := entity.hashDataLayout.Execute(
+		entity.newHashData(nonce),
+	); err != nil {
 		return fmt.Errorf("unable to execute the hash data layout: %w", err)
 	}
 
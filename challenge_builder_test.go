@@ -2,6 +2,7 @@ package pow
 
 import (
 	"crypto/sha256"
+	"math/big"
 	"net/url"
 	"testing"
 	"time"
@@ -53,7 +54,19 @@ func TestChallengeBuilder_Build(test *testing.T) {
 					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
 						":{{ .Challenge.SerializedPayload.ToString }}" +
 						":{{ .Nonce.ToString }}",
-				)),
+				)).
+				SetNonceEncoding(func() powValueTypes.NonceEncoding {
+					value, err := powValueTypes.NewFixedWidthNonceEncoding(8)
+					require.NoError(test, err)
+
+					return value
+				}()).
+				SetSignature(func() powValueTypes.Signature {
+					value, err := powValueTypes.NewSignature("key-1", []byte("dummy"))
+					require.NoError(test, err)
+
+					return value
+				}()),
 			want: Challenge{
 				leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
 					value, err := powValueTypes.NewLeadingZeroBitCount(23)
@@ -87,6 +100,18 @@ func TestChallengeBuilder_Build(test *testing.T) {
 						":{{ .Challenge.SerializedPayload.ToString }}" +
 						":{{ .Nonce.ToString }}",
 				),
+				nonceEncoding: func() mo.Option[powValueTypes.NonceEncoding] {
+					value, err := powValueTypes.NewFixedWidthNonceEncoding(8)
+					require.NoError(test, err)
+
+					return mo.Some(value)
+				}(),
+				signature: func() mo.Option[powValueTypes.Signature] {
+					value, err := powValueTypes.NewSignature("key-1", []byte("dummy"))
+					require.NoError(test, err)
+
+					return mo.Some(value)
+				}(),
 			},
 			wantErr: assert.NoError,
 		},
@@ -160,6 +185,47 @@ func TestChallengeBuilder_Build(test *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "success/required parameters only/target is specified",
+			builder: NewChallengeBuilder().
+				SetTarget(func() powValueTypes.Target {
+					value, err := powValueTypes.NewTarget(big.NewInt(1536))
+					require.NoError(test, err)
+
+					return value
+				}()).
+				SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+				SetHash(powValueTypes.NewHash(sha256.New())).
+				SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+						":{{ .Challenge.SerializedPayload.ToString }}" +
+						":{{ .Nonce.ToString }}",
+				)),
+			want: Challenge{
+				leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+					value, err := powValueTypes.NewLeadingZeroBitCount(245)
+					require.NoError(test, err)
+
+					return value
+				}(),
+				arbitraryTarget: mo.Some(func() powValueTypes.Target {
+					value, err := powValueTypes.NewTarget(big.NewInt(1536))
+					require.NoError(test, err)
+
+					return value
+				}()),
+				createdAt:         mo.None[powValueTypes.CreatedAt](),
+				resource:          mo.None[powValueTypes.Resource](),
+				serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+				hash:              powValueTypes.NewHash(sha256.New()),
+				hashDataLayout: powValueTypes.MustParseHashDataLayout(
+					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+						":{{ .Challenge.SerializedPayload.ToString }}" +
+						":{{ .Nonce.ToString }}",
+				),
+			},
+			wantErr: assert.NoError,
+		},
 		{
 			name:    "error/without parameters",
 			builder: NewChallengeBuilder(),
@@ -299,6 +365,219 @@ func TestChallengeBuilder_Build(test *testing.T) {
 			want:    Challenge{},
 			wantErr: assert.Error,
 		},
+		{
+			name: "error/" +
+				"leading zero bit count and target " +
+				"are specified at the same time",
+			builder: NewChallengeBuilder().
+				SetLeadingZeroBitCount(func() powValueTypes.LeadingZeroBitCount {
+					value, err := powValueTypes.NewLeadingZeroBitCount(23)
+					require.NoError(test, err)
+
+					return value
+				}()).
+				SetTarget(func() powValueTypes.Target {
+					value, err := powValueTypes.NewTarget(big.NewInt(1536))
+					require.NoError(test, err)
+
+					return value
+				}()).
+				SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+				SetHash(powValueTypes.NewHash(sha256.New())).
+				SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+						":{{ .Challenge.SerializedPayload.ToString }}" +
+						":{{ .Nonce.ToString }}",
+				)),
+			want:    Challenge{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/target is too large",
+			builder: NewChallengeBuilder().
+				SetTarget(func() powValueTypes.Target {
+					rawValue := big.NewInt(0)
+					rawValue.SetBit(rawValue, 256, 1)
+					rawValue.Add(rawValue, big.NewInt(1))
+
+					value, err := powValueTypes.NewTarget(rawValue)
+					require.NoError(test, err)
+
+					return value
+				}()).
+				SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+				SetHash(powValueTypes.NewHash(sha256.New())).
+				SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+						":{{ .Challenge.SerializedPayload.ToString }}" +
+						":{{ .Nonce.ToString }}",
+				)),
+			want:    Challenge{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "success/without required hash data fields",
+			builder: NewChallengeBuilder().
+				SetLeadingZeroBitCount(func() powValueTypes.LeadingZeroBitCount {
+					value, err := powValueTypes.NewLeadingZeroBitCount(23)
+					require.NoError(test, err)
+
+					return value
+				}()).
+				SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+				SetHash(powValueTypes.NewHash(sha256.New())).
+				SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+					"{{ .Challenge.SerializedPayload.ToString }}",
+				)).
+				SetRequiredHashDataFields(nil),
+			want: Challenge{
+				leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+					value, err := powValueTypes.NewLeadingZeroBitCount(23)
+					require.NoError(test, err)
+
+					return value
+				}(),
+				serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+				hash:              powValueTypes.NewHash(sha256.New()),
+				hashDataLayout: powValueTypes.MustParseHashDataLayout(
+					"{{ .Challenge.SerializedPayload.ToString }}",
+				),
+
+				requiredHashDataFields: mo.Some([]powValueTypes.HashDataField(nil)),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/hash data layout doesn't refer to the nonce",
+			builder: NewChallengeBuilder().
+				SetLeadingZeroBitCount(func() powValueTypes.LeadingZeroBitCount {
+					value, err := powValueTypes.NewLeadingZeroBitCount(23)
+					require.NoError(test, err)
+
+					return value
+				}()).
+				SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+				SetHash(powValueTypes.NewHash(sha256.New())).
+				SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+						":{{ .Challenge.SerializedPayload.ToString }}",
+				)),
+			want:    Challenge{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/hash data layout doesn't refer to a custom required field",
+			builder: NewChallengeBuilder().
+				SetLeadingZeroBitCount(func() powValueTypes.LeadingZeroBitCount {
+					value, err := powValueTypes.NewLeadingZeroBitCount(23)
+					require.NoError(test, err)
+
+					return value
+				}()).
+				SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+				SetHash(powValueTypes.NewHash(sha256.New())).
+				SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+					"{{ .Challenge.SerializedPayload.ToString }}:{{ .Nonce.ToString }}",
+				)).
+				SetRequiredHashDataFields([]powValueTypes.HashDataField{
+					powValueTypes.HashDataFieldNonce,
+					powValueTypes.HashDataFieldDifficulty,
+					powValueTypes.HashDataFieldPayload,
+				}),
+			want:    Challenge{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "success/hash data layout is specified by name",
+			builder: NewChallengeBuilder().
+				SetLeadingZeroBitCount(func() powValueTypes.LeadingZeroBitCount {
+					value, err := powValueTypes.NewLeadingZeroBitCount(23)
+					require.NoError(test, err)
+
+					return value
+				}()).
+				SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+				SetHash(powValueTypes.NewHash(sha256.New())).
+				SetHashDataLayoutByName(powValueTypes.HashDataLayoutNameV1Colon),
+			want: Challenge{
+				leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+					value, err := powValueTypes.NewLeadingZeroBitCount(23)
+					require.NoError(test, err)
+
+					return value
+				}(),
+				createdAt:         mo.None[powValueTypes.CreatedAt](),
+				resource:          mo.None[powValueTypes.Resource](),
+				serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+				hash:              powValueTypes.NewHash(sha256.New()),
+				hashDataLayout: func() powValueTypes.HashDataLayout {
+					value, err := powValueTypes.LookupPredefinedHashDataLayout(
+						powValueTypes.HashDataLayoutNameV1Colon,
+					)
+					require.NoError(test, err)
+
+					return value
+				}(),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/hash data layout name is unknown",
+			builder: NewChallengeBuilder().
+				SetLeadingZeroBitCount(func() powValueTypes.LeadingZeroBitCount {
+					value, err := powValueTypes.NewLeadingZeroBitCount(23)
+					require.NoError(test, err)
+
+					return value
+				}()).
+				SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+				SetHash(powValueTypes.NewHash(sha256.New())).
+				SetHashDataLayoutByName("dummy"),
+			want:    Challenge{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/hash data layout is specified along with its name",
+			builder: NewChallengeBuilder().
+				SetLeadingZeroBitCount(func() powValueTypes.LeadingZeroBitCount {
+					value, err := powValueTypes.NewLeadingZeroBitCount(23)
+					require.NoError(test, err)
+
+					return value
+				}()).
+				SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+				SetHash(powValueTypes.NewHash(sha256.New())).
+				SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+					"{{ .Challenge.SerializedPayload.ToString }}:{{ .Nonce.ToString }}",
+				)).
+				SetHashDataLayoutByName(powValueTypes.HashDataLayoutNameV1Colon),
+			want:    Challenge{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/nonce encoding is specified along with a binary layout",
+			builder: NewChallengeBuilder().
+				SetLeadingZeroBitCount(func() powValueTypes.LeadingZeroBitCount {
+					value, err := powValueTypes.NewLeadingZeroBitCount(23)
+					require.NoError(test, err)
+
+					return value
+				}()).
+				SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+				SetHash(powValueTypes.NewHash(sha256.New())).
+				SetHashDataLayout(powValueTypes.NewBinaryHashDataLayout(
+					powValueTypes.MustParseBinaryHashDataLayout("payload,nonce:8"),
+				)).
+				SetNonceEncoding(func() powValueTypes.NonceEncoding {
+					value, err :=
+						powValueTypes.NewNonceEncoding(powValueTypes.NonceEncodingKindHex)
+					require.NoError(test, err)
+
+					return value
+				}()),
+			want:    Challenge{},
+			wantErr: assert.Error,
+		},
 		{
 			name: "error/unable to check the hash data layout",
 			builder: NewChallengeBuilder().
@@ -311,7 +590,7 @@ func TestChallengeBuilder_Build(test *testing.T) {
 				SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
 				SetHash(powValueTypes.NewHash(sha256.New())).
 				SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
-					"dummy {{ .Dummy }}",
+					"dummy {{ .Dummy }}:{{ .Nonce.ToString }}",
 				)),
 			want:    Challenge{},
 			wantErr: assert.Error,
@@ -320,8 +599,19 @@ func TestChallengeBuilder_Build(test *testing.T) {
 		test.Run(data.name, func(test *testing.T) {
 			got, err := data.builder.Build()
 
-			assert.Equal(test, data.want, got)
 			data.wantErr(test, err)
+			if err == nil {
+				assert.Equal(
+					test,
+					data.want.hashDataLayout.ToString(),
+					got.hashDataLayout.ToString(),
+				)
+				assert.Equal(test, data.want.hashDataLayout.Kind(), got.hashDataLayout.Kind())
+
+				data.want.hashDataLayout = powValueTypes.HashDataLayout{}
+				got.hashDataLayout = powValueTypes.HashDataLayout{}
+			}
+			assert.Equal(test, data.want, got)
 		})
 	}
 }
@@ -0,0 +1,269 @@
+package pow
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	powRawChallenge "github.com/thewizardplusplus/go-pow/internal/raw-challenge"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+func UnmarshalChallengeJSON(
+	data []byte,
+	hashRegistry *powValueTypes.HashRegistry,
+) (Challenge, error) {
+	var model powRawChallenge.RawChallenge
+	if err := json.Unmarshal(data, &model); err != nil {
+		return Challenge{}, fmt.Errorf(
+			"unable to unmarshal the challenge model: %w",
+			err,
+		)
+	}
+
+	entity, err := newChallengeFromRaw(model, hashRegistry)
+	if err != nil {
+		return Challenge{}, fmt.Errorf(
+			"unable to convert the challenge model: %w",
+			err,
+		)
+	}
+
+	return entity, nil
+}
+
+func (entity Challenge) MarshalJSON() ([]byte, error) {
+	model, err := newChallengeJSONModel(entity)
+	if err != nil {
+		return nil, fmt.Errorf("unable to make the challenge model: %w", err)
+	}
+
+	data, err := json.Marshal(model)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal the challenge model: %w", err)
+	}
+
+	return data, nil
+}
+
+func (entity *Challenge) UnmarshalJSON(data []byte) error {
+	challenge, err :=
+		UnmarshalChallengeJSON(data, powValueTypes.DefaultHashRegistry)
+	if err != nil {
+		return err
+	}
+
+	*entity = challenge
+	return nil
+}
+
+func newChallengeJSONModel(
+	entity Challenge,
+) (powRawChallenge.RawChallenge, error) {
+	// the hash is looked up by its name on unmarshalling,
+	// so a name derived from the type of its instance cannot be resolved
+	if !entity.hash.HasExplicitName() {
+		return powRawChallenge.RawChallenge{}, errors.New(
+			"hash has no explicit name",
+		)
+	}
+
+	return newRawChallenge(entity), nil
+}
+
+func newRawChallenge(entity Challenge) powRawChallenge.RawChallenge {
+	return powRawChallenge.RawChallenge{
+		LeadingZeroBitCount: entity.leadingZeroBitCount.ToInt(),
+		Target: mapOption(
+			entity.arbitraryTarget,
+			powValueTypes.Target.ToString,
+		),
+		CreatedAt: mapOption(
+			entity.createdAt,
+			powValueTypes.CreatedAt.ToString,
+		),
+		TTL: mapOption(entity.ttl, powValueTypes.TTL.ToString),
+		Resource: mapOption(
+			entity.resource,
+			powValueTypes.Resource.ToString,
+		),
+		SerializedPayload:  entity.serializedPayload.ToString(),
+		HashName:           entity.hash.Name(),
+		HashDataLayout:     entity.hashDataLayout.ToString(),
+		HashDataLayoutKind: string(entity.hashDataLayout.Kind()),
+		NonceEncoding: mapOption(
+			entity.nonceEncoding,
+			powValueTypes.NonceEncoding.ToString,
+		),
+		Signature: mapOption(
+			entity.signature,
+			func(value powValueTypes.Signature) powRawChallenge.RawSignature {
+				return powRawChallenge.RawSignature{
+					KeyID: value.KeyID(),
+					Value: value.ToString(),
+				}
+			},
+		),
+		RequiredHashDataFields: mapOption(
+			entity.requiredHashDataFields,
+			func(values []powValueTypes.HashDataField) []string {
+				rawValues := make([]string, 0, len(values))
+				for _, value := range values {
+					rawValues = append(rawValues, string(value))
+				}
+
+				return rawValues
+			},
+		),
+	}
+}
+
+// the kind may be omitted for compatibility with the previous versions
+func hashDataLayoutKindOrDefault(
+	rawKind string,
+) powValueTypes.HashDataLayoutKind {
+	if rawKind == "" {
+		return powValueTypes.HashDataLayoutKindText
+	}
+
+	return powValueTypes.HashDataLayoutKind(rawKind)
+}
+
+func newChallengeFromRaw(
+	model powRawChallenge.RawChallenge,
+	hashRegistry *powValueTypes.HashRegistry,
+) (Challenge, error) {
+	var errs []error
+	builder := NewChallengeBuilder()
+
+	// the leading zero bit count of a challenge with an arbitrary target
+	// is derived from the latter, so it's ignored
+	if rawTarget, isPresent := model.Target.Get(); isPresent {
+		target, err := powValueTypes.ParseTarget(rawTarget)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to parse the target: %w", err))
+		} else {
+			builder.SetTarget(target)
+		}
+	} else {
+		leadingZeroBitCount, err :=
+			powValueTypes.NewLeadingZeroBitCount(model.LeadingZeroBitCount)
+		if err != nil {
+			errs = append(
+				errs,
+				fmt.Errorf("unable to construct the leading zero bit count: %w", err),
+			)
+		} else {
+			builder.SetLeadingZeroBitCount(leadingZeroBitCount)
+		}
+	}
+
+	if rawCreatedAt, isPresent := model.CreatedAt.Get(); isPresent {
+		createdAt, err := powValueTypes.ParseCreatedAt(rawCreatedAt)
+		if err != nil {
+			errs = append(
+				errs,
+				fmt.Errorf("unable to parse the `CreatedAt` timestamp: %w", err),
+			)
+		} else {
+			builder.SetCreatedAt(createdAt)
+		}
+	}
+
+	if rawTTL, isPresent := model.TTL.Get(); isPresent {
+		ttl, err := powValueTypes.ParseTTL(rawTTL)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to parse the TTL: %w", err))
+		} else {
+			builder.SetTTL(ttl)
+		}
+	}
+
+	if rawResource, isPresent := model.Resource.Get(); isPresent {
+		resource, err := powValueTypes.ParseResource(rawResource)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to parse the resource: %w", err))
+		} else {
+			builder.SetResource(resource)
+		}
+	}
+
+	builder.SetSerializedPayload(
+		powValueTypes.NewSerializedPayload(model.SerializedPayload),
+	)
+
+	hash, err := hashRegistry.Lookup(model.HashName)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("unable to look up the hash: %w", err))
+	} else {
+		builder.SetHash(hash)
+	}
+
+	hashDataLayout, err := powValueTypes.ParseHashDataLayoutOfKind(
+		hashDataLayoutKindOrDefault(model.HashDataLayoutKind),
+		model.HashDataLayout,
+	)
+	if err != nil {
+		errs = append(
+			errs,
+			fmt.Errorf("unable to parse the hash data layout: %w", err),
+		)
+	} else {
+		builder.SetHashDataLayout(hashDataLayout)
+	}
+
+	if rawNonceEncoding, isPresent := model.NonceEncoding.Get(); isPresent {
+		nonceEncoding, err := powValueTypes.ParseNonceEncoding(rawNonceEncoding)
+		if err != nil {
+			errs = append(
+				errs,
+				fmt.Errorf("unable to parse the nonce encoding: %w", err),
+			)
+		} else {
+			builder.SetNonceEncoding(nonceEncoding)
+		}
+	}
+
+	if rawSignature, isPresent := model.Signature.Get(); isPresent {
+		signature, err :=
+			powValueTypes.ParseSignature(rawSignature.KeyID, rawSignature.Value)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to parse the signature: %w", err))
+		} else {
+			builder.SetSignature(signature)
+		}
+	}
+
+	if rawRequiredHashDataFields, isPresent :=
+		model.RequiredHashDataFields.Get(); isPresent {
+		requiredHashDataFields :=
+			make([]powValueTypes.HashDataField, 0, len(rawRequiredHashDataFields))
+		for _, rawRequiredHashDataField := range rawRequiredHashDataFields {
+			requiredHashDataField, err :=
+				powValueTypes.ParseHashDataField(rawRequiredHashDataField)
+			if err != nil {
+				errs = append(
+					errs,
+					fmt.Errorf("unable to parse the required hash data field: %w", err),
+				)
+				continue
+			}
+
+			requiredHashDataFields =
+				append(requiredHashDataFields, requiredHashDataField)
+		}
+
+		builder.SetRequiredHashDataFields(requiredHashDataFields)
+	}
+
+	if len(errs) > 0 {
+		return Challenge{}, errors.Join(errs...)
+	}
+
+	challenge, err := builder.Build()
+	if err != nil {
+		return Challenge{}, fmt.Errorf("unable to build the challenge: %w", err)
+	}
+
+	return challenge, nil
+}
@@ -0,0 +1,637 @@
+package pow
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"math/big"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/samber/mo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+func TestChallenge_MarshalJSON(test *testing.T) {
+	type fields struct {
+		leadingZeroBitCount powValueTypes.LeadingZeroBitCount
+		arbitraryTarget     mo.Option[powValueTypes.Target]
+		createdAt           mo.Option[powValueTypes.CreatedAt]
+		ttl                 mo.Option[powValueTypes.TTL]
+		resource            mo.Option[powValueTypes.Resource]
+		serializedPayload   powValueTypes.SerializedPayload
+		hash                powValueTypes.Hash
+		hashDataLayout      powValueTypes.HashDataLayout
+		nonceEncoding       mo.Option[powValueTypes.NonceEncoding]
+		signature           mo.Option[powValueTypes.Signature]
+
+		requiredHashDataFields mo.Option[[]powValueTypes.HashDataField]
+	}
+
+	for _, data := range []struct {
+		name    string
+		fields  fields
+		want    string
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/all parameters",
+			fields: fields{
+				leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+					value, err := powValueTypes.NewLeadingZeroBitCount(23)
+					require.NoError(test, err)
+
+					return value
+				}(),
+				createdAt: func() mo.Option[powValueTypes.CreatedAt] {
+					value, err := powValueTypes.NewCreatedAt(
+						time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC),
+					)
+					require.NoError(test, err)
+
+					return mo.Some(value)
+				}(),
+				ttl: func() mo.Option[powValueTypes.TTL] {
+					value, err := powValueTypes.NewTTL(5*time.Minute + 23*time.Second)
+					require.NoError(test, err)
+
+					return mo.Some(value)
+				}(),
+				resource: mo.Some(powValueTypes.NewResource(&url.URL{
+					Scheme: "https",
+					Host:   "example.com",
+					Path:   "/",
+				})),
+				serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+				hash: func() powValueTypes.Hash {
+					value, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+					require.NoError(test, err)
+
+					return value
+				}(),
+				hashDataLayout: powValueTypes.MustParseHashDataLayout(
+					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+						":{{ .Challenge.SerializedPayload.ToString }}" +
+						":{{ .Nonce.ToString }}",
+				),
+				nonceEncoding: func() mo.Option[powValueTypes.NonceEncoding] {
+					value, err :=
+						powValueTypes.NewNonceEncoding(powValueTypes.NonceEncodingKindHex)
+					require.NoError(test, err)
+
+					return mo.Some(value)
+				}(),
+				signature: func() mo.Option[powValueTypes.Signature] {
+					value, err := powValueTypes.NewSignature("key-1", []byte("dummy"))
+					require.NoError(test, err)
+
+					return mo.Some(value)
+				}(),
+				requiredHashDataFields: mo.Some([]powValueTypes.HashDataField{
+					powValueTypes.HashDataFieldNonce,
+					powValueTypes.HashDataFieldDifficulty,
+				}),
+			},
+			want: `{
+				"leading_zero_bit_count": 23,
+				"target": null,
+				"created_at": "2000-01-02T03:04:05.000000006Z",
+				"ttl": "5m23s",
+				"resource": "https://example.com/",
+				"serialized_payload": "dummy",
+				"hash_name": "SHA-256",
+				"hash_data_layout": "{{.Challenge.LeadingZeroBitCount.ToInt}}` +
+				`:{{.Challenge.SerializedPayload.ToString}}` +
+				`:{{.Nonce.ToString}}",
+				"hash_data_layout_kind": "text",
+				"nonce_encoding": "hex",
+				"signature": {
+					"key_id": "key-1",
+					"value": "64756d6d79"
+				},
+				"required_hash_data_fields": ["nonce", "difficulty"]
+			}`,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/required parameters only",
+			fields: fields{
+				leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+					value, err := powValueTypes.NewLeadingZeroBitCount(23)
+					require.NoError(test, err)
+
+					return value
+				}(),
+				createdAt:         mo.None[powValueTypes.CreatedAt](),
+				ttl:               mo.None[powValueTypes.TTL](),
+				resource:          mo.None[powValueTypes.Resource](),
+				serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+				hash: func() powValueTypes.Hash {
+					value, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+					require.NoError(test, err)
+
+					return value
+				}(),
+				hashDataLayout: powValueTypes.MustParseHashDataLayout(
+					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+						":{{ .Challenge.SerializedPayload.ToString }}" +
+						":{{ .Nonce.ToString }}",
+				),
+			},
+			want: `{
+				"leading_zero_bit_count": 23,
+				"target": null,
+				"created_at": null,
+				"ttl": null,
+				"resource": null,
+				"serialized_payload": "dummy",
+				"hash_name": "SHA-256",
+				"hash_data_layout": "{{.Challenge.LeadingZeroBitCount.ToInt}}` +
+				`:{{.Challenge.SerializedPayload.ToString}}` +
+				`:{{.Nonce.ToString}}",
+				"hash_data_layout_kind": "text",
+				"nonce_encoding": null,
+				"signature": null,
+				"required_hash_data_fields": null
+			}`,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/arbitrary target",
+			fields: fields{
+				leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+					value, err := powValueTypes.NewLeadingZeroBitCount(245)
+					require.NoError(test, err)
+
+					return value
+				}(),
+				arbitraryTarget: mo.Some(func() powValueTypes.Target {
+					value, err := powValueTypes.NewTarget(big.NewInt(1536))
+					require.NoError(test, err)
+
+					return value
+				}()),
+				createdAt:         mo.None[powValueTypes.CreatedAt](),
+				ttl:               mo.None[powValueTypes.TTL](),
+				resource:          mo.None[powValueTypes.Resource](),
+				serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+				hash: func() powValueTypes.Hash {
+					value, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+					require.NoError(test, err)
+
+					return value
+				}(),
+				hashDataLayout: powValueTypes.MustParseHashDataLayout(
+					"{{ .Challenge.SerializedPayload.ToString }}" +
+						":{{ .Nonce.ToString }}",
+				),
+			},
+			want: `{
+				"leading_zero_bit_count": 245,
+				"target": "600",
+				"created_at": null,
+				"ttl": null,
+				"resource": null,
+				"serialized_payload": "dummy",
+				"hash_name": "SHA-256",
+				"hash_data_layout": "{{.Challenge.SerializedPayload.ToString}}` +
+				`:{{.Nonce.ToString}}",
+				"hash_data_layout_kind": "text",
+				"nonce_encoding": null,
+				"signature": null,
+				"required_hash_data_fields": null
+			}`,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/binary hash data layout",
+			fields: fields{
+				leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+					value, err := powValueTypes.NewLeadingZeroBitCount(23)
+					require.NoError(test, err)
+
+					return value
+				}(),
+				createdAt:         mo.None[powValueTypes.CreatedAt](),
+				ttl:               mo.None[powValueTypes.TTL](),
+				resource:          mo.None[powValueTypes.Resource](),
+				serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+				hash: func() powValueTypes.Hash {
+					value, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+					require.NoError(test, err)
+
+					return value
+				}(),
+				hashDataLayout: powValueTypes.NewBinaryHashDataLayout(
+					powValueTypes.MustParseBinaryHashDataLayout(
+						"leading-zero-bit-count,payload,nonce:8",
+					),
+				),
+			},
+			want: `{
+				"leading_zero_bit_count": 23,
+				"target": null,
+				"created_at": null,
+				"ttl": null,
+				"resource": null,
+				"serialized_payload": "dummy",
+				"hash_name": "SHA-256",
+				"hash_data_layout": "leading-zero-bit-count,payload,nonce:8",
+				"hash_data_layout_kind": "binary",
+				"nonce_encoding": null,
+				"signature": null,
+				"required_hash_data_fields": null
+			}`,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/hash without an explicit name",
+			fields: fields{
+				leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+					value, err := powValueTypes.NewLeadingZeroBitCount(23)
+					require.NoError(test, err)
+
+					return value
+				}(),
+				createdAt:         mo.None[powValueTypes.CreatedAt](),
+				ttl:               mo.None[powValueTypes.TTL](),
+				resource:          mo.None[powValueTypes.Resource](),
+				serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+				hash:              powValueTypes.NewHash(sha256.New()),
+				hashDataLayout: powValueTypes.MustParseHashDataLayout(
+					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+						":{{ .Challenge.SerializedPayload.ToString }}" +
+						":{{ .Nonce.ToString }}",
+				),
+			},
+			want:    "",
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			entity := Challenge{
+				leadingZeroBitCount: data.fields.leadingZeroBitCount,
+				arbitraryTarget:     data.fields.arbitraryTarget,
+				createdAt:           data.fields.createdAt,
+				ttl:                 data.fields.ttl,
+				resource:            data.fields.resource,
+				serializedPayload:   data.fields.serializedPayload,
+				hash:                data.fields.hash,
+				hashDataLayout:      data.fields.hashDataLayout,
+				nonceEncoding:       data.fields.nonceEncoding,
+				signature:           data.fields.signature,
+
+				requiredHashDataFields: data.fields.requiredHashDataFields,
+			}
+			got, err := json.Marshal(entity)
+
+			data.wantErr(test, err)
+			if err == nil {
+				assert.JSONEq(test, data.want, string(got))
+			} else {
+				assert.Nil(test, got)
+			}
+		})
+	}
+}
+
+func TestChallenge_UnmarshalJSON(test *testing.T) {
+	type args struct {
+		data string
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		want    string
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/all parameters",
+			args: args{
+				data: `{
+					"leading_zero_bit_count": 23,
+					"created_at": "2000-01-02T03:04:05.000000006Z",
+					"ttl": "5m23s",
+					"resource": "https://example.com/",
+					"serialized_payload": "dummy",
+					"hash_name": "SHA-256",
+					"hash_data_layout": "{{ .Challenge.LeadingZeroBitCount.ToInt }}` +
+					`:{{ .Challenge.SerializedPayload.ToString }}` +
+					`:{{ .Nonce.ToString }}",
+					"hash_data_layout_kind": "text",
+					"nonce_encoding": "bytes:8",
+					"signature": {
+						"key_id": "key-1",
+						"value": "64756d6d79"
+					},
+					"required_hash_data_fields": ["nonce", "difficulty"]
+				}`,
+			},
+			want: `{
+				"leading_zero_bit_count": 23,
+				"target": null,
+				"created_at": "2000-01-02T03:04:05.000000006Z",
+				"ttl": "5m23s",
+				"resource": "https://example.com/",
+				"serialized_payload": "dummy",
+				"hash_name": "SHA-256",
+				"hash_data_layout": "{{.Challenge.LeadingZeroBitCount.ToInt}}` +
+				`:{{.Challenge.SerializedPayload.ToString}}` +
+				`:{{.Nonce.ToString}}",
+				"hash_data_layout_kind": "text",
+				"nonce_encoding": "bytes:8",
+				"signature": {
+					"key_id": "key-1",
+					"value": "64756d6d79"
+				},
+				"required_hash_data_fields": ["nonce", "difficulty"]
+			}`,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/required parameters only",
+			args: args{
+				data: `{
+					"leading_zero_bit_count": 23,
+					"serialized_payload": "dummy",
+					"hash_name": "SHA-256",
+					"hash_data_layout": "{{ .Challenge.LeadingZeroBitCount.ToInt }}` +
+					`:{{ .Challenge.SerializedPayload.ToString }}` +
+					`:{{ .Nonce.ToString }}"
+				}`,
+			},
+			want: `{
+				"leading_zero_bit_count": 23,
+				"target": null,
+				"created_at": null,
+				"ttl": null,
+				"resource": null,
+				"serialized_payload": "dummy",
+				"hash_name": "SHA-256",
+				"hash_data_layout": "{{.Challenge.LeadingZeroBitCount.ToInt}}` +
+				`:{{.Challenge.SerializedPayload.ToString}}` +
+				`:{{.Nonce.ToString}}",
+				"hash_data_layout_kind": "text",
+				"nonce_encoding": null,
+				"signature": null,
+				"required_hash_data_fields": null
+			}`,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/arbitrary target",
+			args: args{
+				data: `{
+					"leading_zero_bit_count": 0,
+					"target": "600",
+					"serialized_payload": "dummy",
+					"hash_name": "SHA-256",
+					"hash_data_layout": "{{ .Challenge.SerializedPayload.ToString }}` +
+					`:{{ .Nonce.ToString }}"
+				}`,
+			},
+			want: `{
+				"leading_zero_bit_count": 245,
+				"target": "600",
+				"created_at": null,
+				"ttl": null,
+				"resource": null,
+				"serialized_payload": "dummy",
+				"hash_name": "SHA-256",
+				"hash_data_layout": "{{.Challenge.SerializedPayload.ToString}}` +
+				`:{{.Nonce.ToString}}",
+				"hash_data_layout_kind": "text",
+				"nonce_encoding": null,
+				"signature": null,
+				"required_hash_data_fields": null
+			}`,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/binary hash data layout",
+			args: args{
+				data: `{
+					"leading_zero_bit_count": 23,
+					"serialized_payload": "dummy",
+					"hash_name": "SHA-256",
+					"hash_data_layout": "leading-zero-bit-count, payload, nonce:8",
+					"hash_data_layout_kind": "binary"
+				}`,
+			},
+			want: `{
+				"leading_zero_bit_count": 23,
+				"target": null,
+				"created_at": null,
+				"ttl": null,
+				"resource": null,
+				"serialized_payload": "dummy",
+				"hash_name": "SHA-256",
+				"hash_data_layout": "leading-zero-bit-count,payload,nonce:8",
+				"hash_data_layout_kind": "binary",
+				"nonce_encoding": null,
+				"signature": null,
+				"required_hash_data_fields": null
+			}`,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/without required hash data fields",
+			args: args{
+				data: `{
+					"leading_zero_bit_count": 23,
+					"serialized_payload": "dummy",
+					"hash_name": "SHA-256",
+					"hash_data_layout": "{{ .Challenge.SerializedPayload.ToString }}",
+					"required_hash_data_fields": []
+				}`,
+			},
+			want: `{
+				"leading_zero_bit_count": 23,
+				"target": null,
+				"created_at": null,
+				"ttl": null,
+				"resource": null,
+				"serialized_payload": "dummy",
+				"hash_name": "SHA-256",
+				"hash_data_layout": "{{.Challenge.SerializedPayload.ToString}}",
+				"hash_data_layout_kind": "text",
+				"nonce_encoding": null,
+				"signature": null,
+				"required_hash_data_fields": []
+			}`,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/unknown hash data layout kind",
+			args: args{
+				data: `{
+					"leading_zero_bit_count": 23,
+					"serialized_payload": "dummy",
+					"hash_name": "SHA-256",
+					"hash_data_layout": "{{ .Nonce.ToString }}",
+					"hash_data_layout_kind": "dummy"
+				}`,
+			},
+			want:    "",
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/invalid JSON",
+			args: args{
+				data: `dummy`,
+			},
+			want:    "",
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/invalid fields",
+			args: args{
+				data: `{
+					"leading_zero_bit_count": -23,
+					"created_at": "dummy",
+					"ttl": "dummy",
+					"resource": ":",
+					"serialized_payload": "dummy",
+					"hash_name": "dummy",
+					"hash_data_layout": "{{ .Dummy",
+					"hash_data_layout_kind": "text",
+					"nonce_encoding": "dummy",
+					"signature": {
+						"key_id": "",
+						"value": "dummy"
+					},
+					"required_hash_data_fields": ["dummy"]
+				}`,
+			},
+			want:    "",
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/invalid target",
+			args: args{
+				data: `{
+					"leading_zero_bit_count": 23,
+					"target": "dummy",
+					"serialized_payload": "dummy",
+					"hash_name": "SHA-256",
+					"hash_data_layout": "{{ .Nonce.ToString }}"
+				}`,
+			},
+			want:    "",
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/missed required hash data field",
+			args: args{
+				data: `{
+					"leading_zero_bit_count": 23,
+					"serialized_payload": "dummy",
+					"hash_name": "SHA-256",
+					"hash_data_layout": "{{ .Nonce.ToString }}",
+					"required_hash_data_fields": ["nonce", "payload"]
+				}`,
+			},
+			want:    "",
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/unable to build the challenge",
+			args: args{
+				data: `{
+					"leading_zero_bit_count": 23,
+					"ttl": "5m23s",
+					"serialized_payload": "dummy",
+					"hash_name": "SHA-256",
+					"hash_data_layout": "{{ .Challenge.LeadingZeroBitCount.ToInt }}` +
+					`:{{ .Challenge.SerializedPayload.ToString }}` +
+					`:{{ .Nonce.ToString }}"
+				}`,
+			},
+			want:    "",
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			var entity Challenge
+			err := json.Unmarshal([]byte(data.args.data), &entity)
+
+			if data.want != "" {
+				got, err := json.Marshal(entity)
+				require.NoError(test, err)
+
+				assert.JSONEq(test, data.want, string(got))
+			}
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestUnmarshalChallengeJSON(test *testing.T) {
+	type args struct {
+		data         string
+		hashRegistry *powValueTypes.HashRegistry
+	}
+
+	for _, data := range []struct {
+		name         string
+		args         args
+		wantHashName string
+		wantErr      assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				data: `{
+					"leading_zero_bit_count": 23,
+					"serialized_payload": "dummy",
+					"hash_name": "SHA-256",
+					"hash_data_layout": "{{ .Nonce.ToString }}"
+				}`,
+				hashRegistry: func() *powValueTypes.HashRegistry {
+					registry, err :=
+						powValueTypes.DefaultHashRegistry.Restricted("SHA-256")
+					require.NoError(test, err)
+
+					return registry
+				}(),
+			},
+			wantHashName: "SHA-256",
+			wantErr:      assert.NoError,
+		},
+		{
+			name: "error",
+			args: args{
+				data: `{
+					"leading_zero_bit_count": 23,
+					"serialized_payload": "dummy",
+					"hash_name": "MD5",
+					"hash_data_layout": "{{ .Nonce.ToString }}"
+				}`,
+				hashRegistry: func() *powValueTypes.HashRegistry {
+					registry, err :=
+						powValueTypes.DefaultHashRegistry.Restricted("SHA-256")
+					require.NoError(test, err)
+
+					return registry
+				}(),
+			},
+			wantHashName: "",
+			wantErr:      assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err := UnmarshalChallengeJSON(
+				[]byte(data.args.data),
+				data.args.hashRegistry,
+			)
+
+			if data.wantHashName != "" {
+				assert.Equal(test, data.wantHashName, got.Hash().Name())
+			}
+			data.wantErr(test, err)
+		})
+	}
+}
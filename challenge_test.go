@@ -3,10 +3,13 @@ package pow
 import (
 	"bytes"
 	"context"
+	"crypto/hmac"
 	"crypto/sha256"
 	"hash"
 	"math/big"
 	"net/url"
+	"strconv"
+	"strings"
 	"testing"
 	"testing/iotest"
 	"time"
@@ -154,6 +157,140 @@ func TestChallenge_TargetBitIndex(test *testing.T) {
 	}
 }
 
+func TestChallenge_ArbitraryTarget(test *testing.T) {
+	type fields struct {
+		arbitraryTarget mo.Option[powValueTypes.Target]
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		want   mo.Option[powValueTypes.Target]
+	}{
+		{
+			name: "success/present",
+			fields: fields{
+				arbitraryTarget: mo.Some(func() powValueTypes.Target {
+					value, err := powValueTypes.NewTarget(big.NewInt(1536))
+					require.NoError(test, err)
+
+					return value
+				}()),
+			},
+			want: mo.Some(func() powValueTypes.Target {
+				value, err := powValueTypes.NewTarget(big.NewInt(1536))
+				require.NoError(test, err)
+
+				return value
+			}()),
+		},
+		{
+			name: "success/absent",
+			fields: fields{
+				arbitraryTarget: mo.None[powValueTypes.Target](),
+			},
+			want: mo.None[powValueTypes.Target](),
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			entity := Challenge{
+				arbitraryTarget: data.fields.arbitraryTarget,
+			}
+			got := entity.ArbitraryTarget()
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
+func TestChallenge_Target(test *testing.T) {
+	type fields struct {
+		leadingZeroBitCount powValueTypes.LeadingZeroBitCount
+		arbitraryTarget     mo.Option[powValueTypes.Target]
+		hash                powValueTypes.Hash
+	}
+
+	for _, data := range []struct {
+		name    string
+		fields  fields
+		want    powValueTypes.Target
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/arbitrary target",
+			fields: fields{
+				leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+					value, err := powValueTypes.NewLeadingZeroBitCount(245)
+					require.NoError(test, err)
+
+					return value
+				}(),
+				arbitraryTarget: mo.Some(func() powValueTypes.Target {
+					value, err := powValueTypes.NewTarget(big.NewInt(1536))
+					require.NoError(test, err)
+
+					return value
+				}()),
+				hash: powValueTypes.NewHash(sha256.New()),
+			},
+			want: func() powValueTypes.Target {
+				value, err := powValueTypes.NewTarget(big.NewInt(1536))
+				require.NoError(test, err)
+
+				return value
+			}(),
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/target of the leading zero bit count",
+			fields: fields{
+				leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+					value, err := powValueTypes.NewLeadingZeroBitCount(246)
+					require.NoError(test, err)
+
+					return value
+				}(),
+				arbitraryTarget: mo.None[powValueTypes.Target](),
+				hash:            powValueTypes.NewHash(sha256.New()),
+			},
+			want: func() powValueTypes.Target {
+				value, err := powValueTypes.NewTarget(big.NewInt(1024))
+				require.NoError(test, err)
+
+				return value
+			}(),
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error",
+			fields: fields{
+				leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+					value, err := powValueTypes.NewLeadingZeroBitCount(1000)
+					require.NoError(test, err)
+
+					return value
+				}(),
+				arbitraryTarget: mo.None[powValueTypes.Target](),
+				hash:            powValueTypes.NewHash(sha256.New()),
+			},
+			want:    powValueTypes.Target{},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			entity := Challenge{
+				leadingZeroBitCount: data.fields.leadingZeroBitCount,
+				arbitraryTarget:     data.fields.arbitraryTarget,
+				hash:                data.fields.hash,
+			}
+			got, err := entity.Target()
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
+
 func TestChallenge_CreatedAt(test *testing.T) {
 	type fields struct {
 		createdAt mo.Option[powValueTypes.CreatedAt]
@@ -309,6 +446,26 @@ func TestChallenge_IsAlive(test *testing.T) {
 			},
 			want: assert.True,
 		},
+		{
+			name: "success/is alive/`CreatedAt` timestamp is in the future",
+			fields: fields{
+				createdAt: func() mo.Option[powValueTypes.CreatedAt] {
+					value, err := powValueTypes.NewCreatedAt(
+						time.Now().Add(365 * 24 * time.Hour),
+					)
+					require.NoError(test, err)
+
+					return mo.Some(value)
+				}(),
+				ttl: func() mo.Option[powValueTypes.TTL] {
+					value, err := powValueTypes.NewTTL(time.Hour)
+					require.NoError(test, err)
+
+					return mo.Some(value)
+				}(),
+			},
+			want: assert.True,
+		},
 		{
 			name: "success/is dead",
 			fields: fields{
@@ -342,145 +499,617 @@ func TestChallenge_IsAlive(test *testing.T) {
 	}
 }
 
-func TestChallenge_Resource(test *testing.T) {
+func TestChallenge_IsAliveAt(test *testing.T) {
 	type fields struct {
-		resource mo.Option[powValueTypes.Resource]
+		createdAt mo.Option[powValueTypes.CreatedAt]
+		ttl       mo.Option[powValueTypes.TTL]
+	}
+	type args struct {
+		moment time.Time
 	}
 
 	for _, data := range []struct {
 		name   string
 		fields fields
-		want   mo.Option[powValueTypes.Resource]
+		args   args
+		want   assert.BoolAssertionFunc
 	}{
 		{
-			name: "success/is present",
+			name: "success/is alive/within the TTL",
 			fields: fields{
-				resource: mo.Some(powValueTypes.NewResource(&url.URL{
-					Scheme: "https",
-					Host:   "example.com",
-					Path:   "/",
-				})),
+				createdAt: func() mo.Option[powValueTypes.CreatedAt] {
+					value, err := powValueTypes.NewCreatedAt(
+						time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC),
+					)
+					require.NoError(test, err)
+
+					return mo.Some(value)
+				}(),
+				ttl: func() mo.Option[powValueTypes.TTL] {
+					value, err := powValueTypes.NewTTL(time.Hour)
+					require.NoError(test, err)
+
+					return mo.Some(value)
+				}(),
 			},
-			want: mo.Some(powValueTypes.NewResource(&url.URL{
-				Scheme: "https",
-				Host:   "example.com",
-				Path:   "/",
-			})),
+			args: args{
+				moment: time.Date(2000, time.January, 2, 3, 34, 5, 6, time.UTC),
+			},
+			want: assert.True,
 		},
 		{
-			name: "success/is absent",
+			name: "success/is alive/`CreatedAt` timestamp is in the future",
 			fields: fields{
-				resource: mo.None[powValueTypes.Resource](),
-			},
-			want: mo.None[powValueTypes.Resource](),
-		},
-	} {
-		test.Run(data.name, func(test *testing.T) {
-			entity := Challenge{
-				resource: data.fields.resource,
-			}
-			got := entity.Resource()
-
-			assert.Equal(test, data.want, got)
-		})
-	}
-}
+				createdAt: func() mo.Option[powValueTypes.CreatedAt] {
+					value, err := powValueTypes.NewCreatedAt(
+						time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC),
+					)
+					require.NoError(test, err)
 
-func TestChallenge_SerializedPayload(test *testing.T) {
-	type fields struct {
-		serializedPayload powValueTypes.SerializedPayload
-	}
+					return mo.Some(value)
+				}(),
+				ttl: func() mo.Option[powValueTypes.TTL] {
+					value, err := powValueTypes.NewTTL(time.Hour)
+					require.NoError(test, err)
 
-	for _, data := range []struct {
-		name   string
-		fields fields
-		want   powValueTypes.SerializedPayload
-	}{
-		{
-			name: "success",
-			fields: fields{
-				serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+					return mo.Some(value)
+				}(),
 			},
-			want: powValueTypes.NewSerializedPayload("dummy"),
+			args: args{
+				moment: time.Date(2000, time.January, 2, 2, 4, 5, 6, time.UTC),
+			},
+			want: assert.True,
 		},
-	} {
-		test.Run(data.name, func(test *testing.T) {
-			entity := Challenge{
-				serializedPayload: data.fields.serializedPayload,
-			}
-			got := entity.SerializedPayload()
-
-			assert.Equal(test, data.want, got)
-		})
-	}
-}
-
-func TestChallenge_Hash(test *testing.T) {
-	type fields struct {
-		hash powValueTypes.Hash
-	}
-
-	for _, data := range []struct {
-		name   string
-		fields fields
-		want   powValueTypes.Hash
-	}{
 		{
-			name: "success",
+			name: "success/is dead",
 			fields: fields{
-				hash: powValueTypes.NewHash(sha256.New()),
+				createdAt: func() mo.Option[powValueTypes.CreatedAt] {
+					value, err := powValueTypes.NewCreatedAt(
+						time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC),
+					)
+					require.NoError(test, err)
+
+					return mo.Some(value)
+				}(),
+				ttl: func() mo.Option[powValueTypes.TTL] {
+					value, err := powValueTypes.NewTTL(time.Hour)
+					require.NoError(test, err)
+
+					return mo.Some(value)
+				}(),
 			},
-			want: powValueTypes.NewHash(sha256.New()),
+			args: args{
+				moment: time.Date(2000, time.January, 2, 5, 4, 5, 6, time.UTC),
+			},
+			want: assert.False,
 		},
 	} {
 		test.Run(data.name, func(test *testing.T) {
 			entity := Challenge{
-				hash: data.fields.hash,
+				createdAt: data.fields.createdAt,
+				ttl:       data.fields.ttl,
 			}
-			got := entity.Hash()
+			got := entity.IsAliveAt(data.args.moment)
 
-			assert.Equal(test, data.want, got)
+			data.want(test, got)
 		})
 	}
 }
 
-func TestChallenge_HashDataLayout(test *testing.T) {
+func TestChallenge_IsAliveWithParams(test *testing.T) {
 	type fields struct {
-		hashDataLayout powValueTypes.HashDataLayout
+		createdAt mo.Option[powValueTypes.CreatedAt]
+		ttl       mo.Option[powValueTypes.TTL]
+	}
+	type args struct {
+		params LivenessParams
 	}
 
 	for _, data := range []struct {
 		name   string
 		fields fields
-		want   powValueTypes.HashDataLayout
+		args   args
+		want   assert.BoolAssertionFunc
 	}{
 		{
-			name: "success",
+			name: "success/is alive/within the TTL",
 			fields: fields{
-				hashDataLayout: powValueTypes.MustParseHashDataLayout(
-					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
-						":{{ .Challenge.SerializedPayload.ToString }}" +
+				createdAt: func() mo.Option[powValueTypes.CreatedAt] {
+					value, err := powValueTypes.NewCreatedAt(
+						time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC),
+					)
+					require.NoError(test, err)
+
+					return mo.Some(value)
+				}(),
+				ttl: func() mo.Option[powValueTypes.TTL] {
+					value, err := powValueTypes.NewTTL(time.Hour)
+					require.NoError(test, err)
+
+					return mo.Some(value)
+				}(),
+			},
+			args: args{
+				params: LivenessParams{
+					Clock: mo.Some[Clock](NewFixedClock(
+						time.Date(2000, time.January, 2, 3, 34, 5, 6, time.UTC),
+					)),
+					ClockSkewTolerance: mo.Some(time.Minute),
+				},
+			},
+			want: assert.True,
+		},
+		{
+			name: "success/is alive/within the clock skew tolerance",
+			fields: fields{
+				createdAt: func() mo.Option[powValueTypes.CreatedAt] {
+					value, err := powValueTypes.NewCreatedAt(
+						time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC),
+					)
+					require.NoError(test, err)
+
+					return mo.Some(value)
+				}(),
+				ttl: func() mo.Option[powValueTypes.TTL] {
+					value, err := powValueTypes.NewTTL(time.Hour)
+					require.NoError(test, err)
+
+					return mo.Some(value)
+				}(),
+			},
+			args: args{
+				params: LivenessParams{
+					Clock: mo.Some[Clock](NewFixedClock(
+						time.Date(2000, time.January, 2, 3, 3, 5, 6, time.UTC),
+					)),
+					ClockSkewTolerance: mo.Some(time.Minute),
+				},
+			},
+			want: assert.True,
+		},
+		{
+			name: "success/is dead/in the future without a clock skew tolerance",
+			fields: fields{
+				createdAt: func() mo.Option[powValueTypes.CreatedAt] {
+					value, err := powValueTypes.NewCreatedAt(
+						time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC),
+					)
+					require.NoError(test, err)
+
+					return mo.Some(value)
+				}(),
+				ttl: func() mo.Option[powValueTypes.TTL] {
+					value, err := powValueTypes.NewTTL(time.Hour)
+					require.NoError(test, err)
+
+					return mo.Some(value)
+				}(),
+			},
+			args: args{
+				params: LivenessParams{
+					Clock: mo.Some[Clock](NewFixedClock(
+						time.Date(2000, time.January, 2, 2, 4, 5, 6, time.UTC),
+					)),
+					ClockSkewTolerance: mo.None[time.Duration](),
+				},
+			},
+			want: assert.False,
+		},
+		{
+			name: "success/is dead/out of the TTL",
+			fields: fields{
+				createdAt: func() mo.Option[powValueTypes.CreatedAt] {
+					value, err := powValueTypes.NewCreatedAt(
+						time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC),
+					)
+					require.NoError(test, err)
+
+					return mo.Some(value)
+				}(),
+				ttl: func() mo.Option[powValueTypes.TTL] {
+					value, err := powValueTypes.NewTTL(time.Hour)
+					require.NoError(test, err)
+
+					return mo.Some(value)
+				}(),
+			},
+			args: args{
+				params: LivenessParams{
+					Clock: mo.Some[Clock](NewFixedClock(
+						time.Date(2000, time.January, 2, 5, 4, 5, 6, time.UTC),
+					)),
+					ClockSkewTolerance: mo.Some(time.Minute),
+				},
+			},
+			want: assert.False,
+		},
+		{
+			name: "success/is dead/out of the clock skew tolerance",
+			fields: fields{
+				createdAt: func() mo.Option[powValueTypes.CreatedAt] {
+					value, err := powValueTypes.NewCreatedAt(
+						time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC),
+					)
+					require.NoError(test, err)
+
+					return mo.Some(value)
+				}(),
+				ttl: func() mo.Option[powValueTypes.TTL] {
+					value, err := powValueTypes.NewTTL(time.Hour)
+					require.NoError(test, err)
+
+					return mo.Some(value)
+				}(),
+			},
+			args: args{
+				params: LivenessParams{
+					Clock: mo.Some[Clock](NewFixedClock(
+						time.Date(2000, time.January, 2, 2, 4, 5, 6, time.UTC),
+					)),
+					ClockSkewTolerance: mo.Some(time.Minute),
+				},
+			},
+			want: assert.False,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			entity := Challenge{
+				createdAt: data.fields.createdAt,
+				ttl:       data.fields.ttl,
+			}
+			got := entity.IsAliveWithParams(data.args.params)
+
+			data.want(test, got)
+		})
+	}
+}
+
+func TestChallenge_Resource(test *testing.T) {
+	type fields struct {
+		resource mo.Option[powValueTypes.Resource]
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		want   mo.Option[powValueTypes.Resource]
+	}{
+		{
+			name: "success/is present",
+			fields: fields{
+				resource: mo.Some(powValueTypes.NewResource(&url.URL{
+					Scheme: "https",
+					Host:   "example.com",
+					Path:   "/",
+				})),
+			},
+			want: mo.Some(powValueTypes.NewResource(&url.URL{
+				Scheme: "https",
+				Host:   "example.com",
+				Path:   "/",
+			})),
+		},
+		{
+			name: "success/is absent",
+			fields: fields{
+				resource: mo.None[powValueTypes.Resource](),
+			},
+			want: mo.None[powValueTypes.Resource](),
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			entity := Challenge{
+				resource: data.fields.resource,
+			}
+			got := entity.Resource()
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
+func TestChallenge_SerializedPayload(test *testing.T) {
+	type fields struct {
+		serializedPayload powValueTypes.SerializedPayload
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		want   powValueTypes.SerializedPayload
+	}{
+		{
+			name: "success",
+			fields: fields{
+				serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+			},
+			want: powValueTypes.NewSerializedPayload("dummy"),
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			entity := Challenge{
+				serializedPayload: data.fields.serializedPayload,
+			}
+			got := entity.SerializedPayload()
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
+func TestChallenge_Hash(test *testing.T) {
+	type fields struct {
+		hash powValueTypes.Hash
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		want   powValueTypes.Hash
+	}{
+		{
+			name: "success",
+			fields: fields{
+				hash: powValueTypes.NewHash(sha256.New()),
+			},
+			want: powValueTypes.NewHash(sha256.New()),
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			entity := Challenge{
+				hash: data.fields.hash,
+			}
+			got := entity.Hash()
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
+func TestChallenge_HashDataLayout(test *testing.T) {
+	type fields struct {
+		hashDataLayout powValueTypes.HashDataLayout
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		want   powValueTypes.HashDataLayout
+	}{
+		{
+			name: "success",
+			fields: fields{
+				hashDataLayout: powValueTypes.MustParseHashDataLayout(
+					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+						":{{ .Challenge.SerializedPayload.ToString }}" +
 						":{{ .Nonce.ToString }}",
 				),
 			},
-			want: powValueTypes.MustParseHashDataLayout(
-				"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
-					":{{ .Challenge.SerializedPayload.ToString }}" +
-					":{{ .Nonce.ToString }}",
-			),
+			want: powValueTypes.MustParseHashDataLayout(
+				"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+					":{{ .Challenge.SerializedPayload.ToString }}" +
+					":{{ .Nonce.ToString }}",
+			),
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			entity := Challenge{
+				hashDataLayout: data.fields.hashDataLayout,
+			}
+			got := entity.HashDataLayout()
+
+			assert.Equal(test, data.want.ToString(), got.ToString())
+			assert.Equal(test, data.want.Kind(), got.Kind())
+		})
+	}
+}
+
+func TestChallenge_NonceEncoding(test *testing.T) {
+	type fields struct {
+		nonceEncoding mo.Option[powValueTypes.NonceEncoding]
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		want   mo.Option[powValueTypes.NonceEncoding]
+	}{
+		{
+			name: "success/is present",
+			fields: fields{
+				nonceEncoding: func() mo.Option[powValueTypes.NonceEncoding] {
+					value, err := powValueTypes.NewFixedWidthNonceEncoding(8)
+					require.NoError(test, err)
+
+					return mo.Some(value)
+				}(),
+			},
+			want: func() mo.Option[powValueTypes.NonceEncoding] {
+				value, err := powValueTypes.NewFixedWidthNonceEncoding(8)
+				require.NoError(test, err)
+
+				return mo.Some(value)
+			}(),
+		},
+		{
+			name: "success/is absent",
+			fields: fields{
+				nonceEncoding: mo.None[powValueTypes.NonceEncoding](),
+			},
+			want: mo.None[powValueTypes.NonceEncoding](),
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			entity := Challenge{
+				nonceEncoding: data.fields.nonceEncoding,
+			}
+			got := entity.NonceEncoding()
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
+func TestChallenge_Signature(test *testing.T) {
+	type fields struct {
+		signature mo.Option[powValueTypes.Signature]
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		want   mo.Option[powValueTypes.Signature]
+	}{
+		{
+			name: "success/is present",
+			fields: fields{
+				signature: func() mo.Option[powValueTypes.Signature] {
+					value, err := powValueTypes.NewSignature("key-1", []byte("dummy"))
+					require.NoError(test, err)
+
+					return mo.Some(value)
+				}(),
+			},
+			want: func() mo.Option[powValueTypes.Signature] {
+				value, err := powValueTypes.NewSignature("key-1", []byte("dummy"))
+				require.NoError(test, err)
+
+				return mo.Some(value)
+			}(),
+		},
+		{
+			name: "success/is absent",
+			fields: fields{
+				signature: mo.None[powValueTypes.Signature](),
+			},
+			want: mo.None[powValueTypes.Signature](),
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			entity := Challenge{
+				signature: data.fields.signature,
+			}
+			got := entity.Signature()
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
+func TestChallenge_RequiredHashDataFields(test *testing.T) {
+	type fields struct {
+		requiredHashDataFields mo.Option[[]powValueTypes.HashDataField]
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		want   mo.Option[[]powValueTypes.HashDataField]
+	}{
+		{
+			name: "success/is present",
+			fields: fields{
+				requiredHashDataFields: mo.Some([]powValueTypes.HashDataField{
+					powValueTypes.HashDataFieldNonce,
+					powValueTypes.HashDataFieldPayload,
+				}),
+			},
+			want: mo.Some([]powValueTypes.HashDataField{
+				powValueTypes.HashDataFieldNonce,
+				powValueTypes.HashDataFieldPayload,
+			}),
+		},
+		{
+			name: "success/is absent",
+			fields: fields{
+				requiredHashDataFields: mo.None[[]powValueTypes.HashDataField](),
+			},
+			want: mo.None[[]powValueTypes.HashDataField](),
 		},
 	} {
 		test.Run(data.name, func(test *testing.T) {
 			entity := Challenge{
-				hashDataLayout: data.fields.hashDataLayout,
+				requiredHashDataFields: data.fields.requiredHashDataFields,
 			}
-			got := entity.HashDataLayout()
+			got := entity.RequiredHashDataFields()
 
 			assert.Equal(test, data.want, got)
 		})
 	}
 }
 
+func TestChallenge_WithSignature(test *testing.T) {
+	type fields struct {
+		signature mo.Option[powValueTypes.Signature]
+	}
+	type args struct {
+		signature powValueTypes.Signature
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		args   args
+		want   mo.Option[powValueTypes.Signature]
+	}{
+		{
+			name: "success/without a signature",
+			fields: fields{
+				signature: mo.None[powValueTypes.Signature](),
+			},
+			args: args{
+				signature: func() powValueTypes.Signature {
+					value, err := powValueTypes.NewSignature("key-1", []byte("dummy"))
+					require.NoError(test, err)
+
+					return value
+				}(),
+			},
+			want: func() mo.Option[powValueTypes.Signature] {
+				value, err := powValueTypes.NewSignature("key-1", []byte("dummy"))
+				require.NoError(test, err)
+
+				return mo.Some(value)
+			}(),
+		},
+		{
+			name: "success/with a signature",
+			fields: fields{
+				signature: func() mo.Option[powValueTypes.Signature] {
+					value, err := powValueTypes.NewSignature("key-1", []byte("old"))
+					require.NoError(test, err)
+
+					return mo.Some(value)
+				}(),
+			},
+			args: args{
+				signature: func() powValueTypes.Signature {
+					value, err := powValueTypes.NewSignature("key-2", []byte("new"))
+					require.NoError(test, err)
+
+					return value
+				}(),
+			},
+			want: func() mo.Option[powValueTypes.Signature] {
+				value, err := powValueTypes.NewSignature("key-2", []byte("new"))
+				require.NoError(test, err)
+
+				return mo.Some(value)
+			}(),
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			entity := Challenge{
+				signature: data.fields.signature,
+			}
+			got := entity.WithSignature(data.args.signature)
+
+			assert.Equal(test, data.want, got.Signature())
+			assert.Equal(test, data.fields.signature, entity.Signature())
+		})
+	}
+}
+
 func TestChallenge_Solve(test *testing.T) {
 	type fields struct {
 		leadingZeroBitCount powValueTypes.LeadingZeroBitCount
@@ -501,7 +1130,213 @@ func TestChallenge_Solve(test *testing.T) {
 		wantErr assert.ErrorAssertionFunc
 	}{
 		{
-			name: "success/zero initial nonce",
+			name: "success/zero initial nonce",
+			fields: fields{
+				leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+					value, err := powValueTypes.NewLeadingZeroBitCount(5)
+					require.NoError(test, err)
+
+					return value
+				}(),
+				serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+				hash:              powValueTypes.NewHash(sha256.New()),
+				hashDataLayout: powValueTypes.MustParseHashDataLayout(
+					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+						":{{ .Challenge.SerializedPayload.ToString }}" +
+						":{{ .Nonce.ToString }}",
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: SolveParams{},
+			},
+			want: Solution{
+				challenge: Challenge{
+					leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(5)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+					hash: powValueTypes.NewHash(func() hash.Hash {
+						hash := sha256.New()
+						hash.Write([]byte("5:dummy:37"))
+
+						return hash
+					}()),
+					hashDataLayout: powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+							":{{ .Challenge.SerializedPayload.ToString }}" +
+							":{{ .Nonce.ToString }}",
+					),
+				},
+				nonce: func() powValueTypes.Nonce {
+					value, err := powValueTypes.NewNonce(big.NewInt(37))
+					require.NoError(test, err)
+
+					return value
+				}(),
+				hashSum: mo.Some(powValueTypes.NewHashSum([]byte{
+					0x00, 0x5d, 0x37, 0x2c, 0x56, 0xe6, 0xc6, 0xb5,
+					0x2a, 0xd4, 0xa8, 0x32, 0x56, 0x54, 0x69, 0x2e,
+					0xc9, 0xaa, 0x3a, 0xf5, 0xf7, 0x30, 0x21, 0x74,
+					0x8b, 0xc3, 0xfd, 0xb1, 0x24, 0xae, 0x9b, 0x20,
+				})),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/random initial nonce",
+			fields: fields{
+				leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+					value, err := powValueTypes.NewLeadingZeroBitCount(5)
+					require.NoError(test, err)
+
+					return value
+				}(),
+				serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+				hash:              powValueTypes.NewHash(sha256.New()),
+				hashDataLayout: powValueTypes.MustParseHashDataLayout(
+					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+						":{{ .Challenge.SerializedPayload.ToString }}" +
+						":{{ .Nonce.ToString }}",
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				params: SolveParams{
+					RandomInitialNonceParams: mo.Some(powValueTypes.RandomNonceParams{
+						RandomReader: bytes.NewReader([]byte("dummy")),
+						MinRawValue:  big.NewInt(123),
+						MaxRawValue:  big.NewInt(142),
+					}),
+				},
+			},
+			want: Solution{
+				challenge: Challenge{
+					leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(5)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+					hash: powValueTypes.NewHash(func() hash.Hash {
+						hash := sha256.New()
+						hash.Write([]byte("5:dummy:129"))
+
+						return hash
+					}()),
+					hashDataLayout: powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+							":{{ .Challenge.SerializedPayload.ToString }}" +
+							":{{ .Nonce.ToString }}",
+					),
+				},
+				nonce: func() powValueTypes.Nonce {
+					value, err := powValueTypes.NewNonce(big.NewInt(129))
+					require.NoError(test, err)
+
+					return value
+				}(),
+				hashSum: mo.Some(powValueTypes.NewHashSum([]byte{
+					0x01, 0x2b, 0x29, 0x61, 0x88, 0x31, 0x8c, 0xa1,
+					0xe0, 0x93, 0x88, 0x91, 0x93, 0x32, 0x14, 0x48,
+					0xd5, 0xd7, 0x11, 0x49, 0x46, 0xe6, 0x68, 0x1c,
+					0x07, 0x75, 0xdb, 0x4c, 0xdc, 0x4e, 0x76, 0xb3,
+				})),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/unable to generate the random initial nonce/regular error",
+			fields: fields{
+				leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+					value, err := powValueTypes.NewLeadingZeroBitCount(5)
+					require.NoError(test, err)
+
+					return value
+				}(),
+				serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+				hash:              powValueTypes.NewHash(sha256.New()),
+				hashDataLayout: powValueTypes.MustParseHashDataLayout(
+					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+						":{{ .Challenge.SerializedPayload.ToString }}" +
+						":{{ .Nonce.ToString }}",
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				params: SolveParams{
+					RandomInitialNonceParams: mo.Some(powValueTypes.RandomNonceParams{
+						RandomReader: bytes.NewReader([]byte("dummy")),
+						MinRawValue:  big.NewInt(142),
+						MaxRawValue:  big.NewInt(123),
+					}),
+				},
+			},
+			want:    Solution{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/unable to generate the random initial nonce/I/O error",
+			fields: fields{
+				leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+					value, err := powValueTypes.NewLeadingZeroBitCount(5)
+					require.NoError(test, err)
+
+					return value
+				}(),
+				serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+				hash:              powValueTypes.NewHash(sha256.New()),
+				hashDataLayout: powValueTypes.MustParseHashDataLayout(
+					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+						":{{ .Challenge.SerializedPayload.ToString }}" +
+						":{{ .Nonce.ToString }}",
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				params: SolveParams{
+					RandomInitialNonceParams: mo.Some(powValueTypes.RandomNonceParams{
+						RandomReader: iotest.ErrReader(iotest.ErrTimeout),
+						MinRawValue:  big.NewInt(123),
+						MaxRawValue:  big.NewInt(142),
+					}),
+				},
+			},
+			want: Solution{},
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrIO)
+			},
+		},
+		{
+			name: "error/unable to get the target bit index",
+			fields: fields{
+				leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+					value, err := powValueTypes.NewLeadingZeroBitCount(1000)
+					require.NoError(test, err)
+
+					return value
+				}(),
+				serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+				hash:              powValueTypes.NewHash(sha256.New()),
+				hashDataLayout: powValueTypes.MustParseHashDataLayout(
+					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+						":{{ .Challenge.SerializedPayload.ToString }}" +
+						":{{ .Nonce.ToString }}",
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: SolveParams{},
+			},
+			want:    Solution{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/context is done",
 			fields: fields{
 				leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
 					value, err := powValueTypes.NewLeadingZeroBitCount(5)
@@ -518,47 +1353,104 @@ func TestChallenge_Solve(test *testing.T) {
 				),
 			},
 			args: args{
-				ctx:    context.Background(),
+				ctx: func() context.Context {
+					ctx, ctxCancel := context.WithCancel(context.Background())
+					ctxCancel()
+
+					return ctx
+				}(),
 				params: SolveParams{},
 			},
-			want: Solution{
-				challenge: Challenge{
-					leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
-						value, err := powValueTypes.NewLeadingZeroBitCount(5)
-						require.NoError(test, err)
+			want: Solution{},
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrTaskInterruption)
+			},
+		},
+		{
+			name: "error/maximal attempt count is exceeded",
+			fields: fields{
+				leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+					value, err := powValueTypes.NewLeadingZeroBitCount(5)
+					require.NoError(test, err)
 
-						return value
-					}(),
-					serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
-					hash: powValueTypes.NewHash(func() hash.Hash {
-						hash := sha256.New()
-						hash.Write([]byte("5:dummy:37"))
+					return value
+				}(),
+				serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+				hash:              powValueTypes.NewHash(sha256.New()),
+				hashDataLayout: powValueTypes.MustParseHashDataLayout(
+					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+						":{{ .Challenge.SerializedPayload.ToString }}" +
+						":{{ .Nonce.ToString }}",
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				params: SolveParams{
+					MaxAttemptCount: mo.Some(23),
+				},
+			},
+			want: Solution{},
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrTaskInterruption)
+			},
+		},
+		{
+			name: "error/maximal attempt count is exceeded/concurrently",
+			fields: fields{
+				leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+					value, err := powValueTypes.NewLeadingZeroBitCount(10)
+					require.NoError(test, err)
 
-						return hash
-					}()),
-					hashDataLayout: powValueTypes.MustParseHashDataLayout(
-						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
-							":{{ .Challenge.SerializedPayload.ToString }}" +
-							":{{ .Nonce.ToString }}",
-					),
+					return value
+				}(),
+				serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+				hash:              powValueTypes.NewHashFromFactory(sha256.New),
+				hashDataLayout: powValueTypes.MustParseHashDataLayout(
+					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+						":{{ .Challenge.SerializedPayload.ToString }}" +
+						":{{ .Nonce.ToString }}",
+				),
+			},
+			args: args{
+				ctx: context.Background(),
+				params: SolveParams{
+					MaxAttemptCount:   mo.Some(23),
+					ConcurrencyFactor: mo.Some(4),
 				},
-				nonce: func() powValueTypes.Nonce {
-					value, err := powValueTypes.NewNonce(big.NewInt(37))
+			},
+			want: Solution{},
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrTaskInterruption)
+			},
+		},
+		{
+			name: "error/concurrency factor isn't positive",
+			fields: fields{
+				leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+					value, err := powValueTypes.NewLeadingZeroBitCount(5)
 					require.NoError(test, err)
 
 					return value
 				}(),
-				hashSum: mo.Some(powValueTypes.NewHashSum([]byte{
-					0x00, 0x5d, 0x37, 0x2c, 0x56, 0xe6, 0xc6, 0xb5,
-					0x2a, 0xd4, 0xa8, 0x32, 0x56, 0x54, 0x69, 0x2e,
-					0xc9, 0xaa, 0x3a, 0xf5, 0xf7, 0x30, 0x21, 0x74,
-					0x8b, 0xc3, 0xfd, 0xb1, 0x24, 0xae, 0x9b, 0x20,
-				})),
+				serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+				hash:              powValueTypes.NewHashFromFactory(sha256.New),
+				hashDataLayout: powValueTypes.MustParseHashDataLayout(
+					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+						":{{ .Challenge.SerializedPayload.ToString }}" +
+						":{{ .Nonce.ToString }}",
+				),
 			},
-			wantErr: assert.NoError,
+			args: args{
+				ctx: context.Background(),
+				params: SolveParams{
+					ConcurrencyFactor: mo.Some(0),
+				},
+			},
+			want:    Solution{},
+			wantErr: assert.Error,
 		},
 		{
-			name: "success/random initial nonce",
+			name: "error/unable to execute the hash data layout",
 			fields: fields{
 				leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
 					value, err := powValueTypes.NewLeadingZeroBitCount(5)
@@ -568,237 +1460,643 @@ func TestChallenge_Solve(test *testing.T) {
 				}(),
 				serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
 				hash:              powValueTypes.NewHash(sha256.New()),
+				hashDataLayout: powValueTypes.MustParseHashDataLayout(
+					"dummy {{ .Dummy }}",
+				),
+			},
+			args: args{
+				ctx:    context.Background(),
+				params: SolveParams{},
+			},
+			want:    Solution{},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			entity := Challenge{
+				leadingZeroBitCount: data.fields.leadingZeroBitCount,
+				serializedPayload:   data.fields.serializedPayload,
+				hash:                data.fields.hash,
+				hashDataLayout:      data.fields.hashDataLayout,
+			}
+			got, err := entity.Solve(data.args.ctx, data.args.params)
+
+			data.wantErr(test, err)
+			if err == nil {
+				assert.Equal(
+					test,
+					data.want.challenge.hashDataLayout.ToString(),
+					got.challenge.hashDataLayout.ToString(),
+				)
+				assert.Equal(test, data.want.challenge.hashDataLayout.Kind(), got.challenge.hashDataLayout.Kind())
+
+				data.want.challenge.hashDataLayout = powValueTypes.HashDataLayout{}
+				got.challenge.hashDataLayout = powValueTypes.HashDataLayout{}
+			}
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
+func TestChallenge_Solve_concurrently(test *testing.T) {
+	for _, data := range []struct {
+		name    string
+		hash    powValueTypes.Hash
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name:    "success/hash from an instance",
+			hash:    powValueTypes.NewHash(sha256.New()),
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "success/hash from a factory",
+			hash:    powValueTypes.NewHashFromFactory(sha256.New),
+			wantErr: assert.NoError,
+		},
+		{
+			name:    "error/hash from an instance that cannot be cloned",
+			hash:    powValueTypes.NewHash(hmac.New(sha256.New, []byte("dummy"))),
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(10)
+			require.NoError(test, err)
+
+			entity := Challenge{
+				leadingZeroBitCount: leadingZeroBitCount,
+				serializedPayload:   powValueTypes.NewSerializedPayload("dummy"),
+				hash:                data.hash,
 				hashDataLayout: powValueTypes.MustParseHashDataLayout(
 					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
 						":{{ .Challenge.SerializedPayload.ToString }}" +
 						":{{ .Nonce.ToString }}",
 				),
+			}
+			got, err := entity.Solve(context.Background(), SolveParams{
+				ConcurrencyFactor: mo.Some(4),
+			})
+
+			data.wantErr(test, err)
+			if err == nil {
+				assert.NoError(test, got.Verify())
+			}
+		})
+	}
+}
+
+func TestChallenge_Solve_withNonceRange(test *testing.T) {
+	type args struct {
+		params SolveParams
+	}
+
+	for _, data := range []struct {
+		name      string
+		args      args
+		wantNonce mo.Option[int64]
+		wantErr   assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/single worker",
+			args: args{
+				params: SolveParams{
+					NonceRange: mo.Some(func() powValueTypes.NonceRange {
+						start, err := powValueTypes.NewNonce(big.NewInt(0))
+						require.NoError(test, err)
+
+						end, err := powValueTypes.NewNonce(big.NewInt(1000))
+						require.NoError(test, err)
+
+						nonceRange, err := powValueTypes.NewNonceRange(start, end)
+						require.NoError(test, err)
+
+						return nonceRange
+					}()),
+				},
 			},
+			wantNonce: mo.Some[int64](470),
+			wantErr:   assert.NoError,
+		},
+		{
+			name: "success/single worker/range with a shifted start",
 			args: args{
-				ctx: context.Background(),
 				params: SolveParams{
-					RandomInitialNonceParams: mo.Some(powValueTypes.RandomNonceParams{
-						RandomReader: bytes.NewReader([]byte("dummy")),
-						MinRawValue:  big.NewInt(123),
-						MaxRawValue:  big.NewInt(142),
-					}),
+					NonceRange: mo.Some(func() powValueTypes.NonceRange {
+						start, err := powValueTypes.NewNonce(big.NewInt(471))
+						require.NoError(test, err)
+
+						end, err := powValueTypes.NewNonce(big.NewInt(1000))
+						require.NoError(test, err)
+
+						nonceRange, err := powValueTypes.NewNonceRange(start, end)
+						require.NoError(test, err)
+
+						return nonceRange
+					}()),
 				},
 			},
-			want: Solution{
-				challenge: Challenge{
-					leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
-						value, err := powValueTypes.NewLeadingZeroBitCount(5)
+			wantNonce: mo.Some[int64](479),
+			wantErr:   assert.NoError,
+		},
+		{
+			name: "success/several workers",
+			args: args{
+				params: SolveParams{
+					ConcurrencyFactor: mo.Some(4),
+					NonceRange: mo.Some(func() powValueTypes.NonceRange {
+						start, err := powValueTypes.NewNonce(big.NewInt(471))
 						require.NoError(test, err)
 
-						return value
-					}(),
-					serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
-					hash: powValueTypes.NewHash(func() hash.Hash {
-						hash := sha256.New()
-						hash.Write([]byte("5:dummy:129"))
+						end, err := powValueTypes.NewNonce(big.NewInt(1000))
+						require.NoError(test, err)
 
-						return hash
+						nonceRange, err := powValueTypes.NewNonceRange(start, end)
+						require.NoError(test, err)
+
+						return nonceRange
 					}()),
-					hashDataLayout: powValueTypes.MustParseHashDataLayout(
-						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
-							":{{ .Challenge.SerializedPayload.ToString }}" +
-							":{{ .Nonce.ToString }}",
-					),
 				},
-				nonce: func() powValueTypes.Nonce {
-					value, err := powValueTypes.NewNonce(big.NewInt(129))
-					require.NoError(test, err)
+			},
+			wantNonce: mo.Some[int64](479),
+			wantErr:   assert.NoError,
+		},
+		{
+			name: "success/with the checkpoint",
+			args: args{
+				params: SolveParams{
+					Checkpoint: mo.Some(SolveCheckpoint{
+						WorkerNextNonces: []powValueTypes.Nonce{
+							func() powValueTypes.Nonce {
+								value, err := powValueTypes.NewNonce(big.NewInt(471))
+								require.NoError(test, err)
+
+								return value
+							}(),
+						},
+						AttemptCount: 471,
+					}),
+					NonceRange: mo.Some(func() powValueTypes.NonceRange {
+						start, err := powValueTypes.NewNonce(big.NewInt(0))
+						require.NoError(test, err)
+
+						end, err := powValueTypes.NewNonce(big.NewInt(1000))
+						require.NoError(test, err)
+
+						nonceRange, err := powValueTypes.NewNonceRange(start, end)
+						require.NoError(test, err)
+
+						return nonceRange
+					}()),
+				},
+			},
+			wantNonce: mo.Some[int64](479),
+			wantErr:   assert.NoError,
+		},
+		{
+			name: "error/single worker/range is exhausted",
+			args: args{
+				params: SolveParams{
+					NonceRange: mo.Some(func() powValueTypes.NonceRange {
+						start, err := powValueTypes.NewNonce(big.NewInt(480))
+						require.NoError(test, err)
+
+						end, err := powValueTypes.NewNonce(big.NewInt(600))
+						require.NoError(test, err)
 
-					return value
-				}(),
-				hashSum: mo.Some(powValueTypes.NewHashSum([]byte{
-					0x01, 0x2b, 0x29, 0x61, 0x88, 0x31, 0x8c, 0xa1,
-					0xe0, 0x93, 0x88, 0x91, 0x93, 0x32, 0x14, 0x48,
-					0xd5, 0xd7, 0x11, 0x49, 0x46, 0xe6, 0x68, 0x1c,
-					0x07, 0x75, 0xdb, 0x4c, 0xdc, 0x4e, 0x76, 0xb3,
-				})),
+						nonceRange, err := powValueTypes.NewNonceRange(start, end)
+						require.NoError(test, err)
+
+						return nonceRange
+					}()),
+				},
+			},
+			wantNonce: mo.None[int64](),
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrNonceRangeExhausted) &&
+					assert.NotErrorIs(test, err, powErrors.ErrTaskInterruption)
 			},
-			wantErr: assert.NoError,
 		},
 		{
-			name: "error/unable to generate the random initial nonce/regular error",
-			fields: fields{
-				leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
-					value, err := powValueTypes.NewLeadingZeroBitCount(5)
-					require.NoError(test, err)
+			name: "error/several workers/range is exhausted",
+			args: args{
+				params: SolveParams{
+					ConcurrencyFactor: mo.Some(4),
+					NonceRange: mo.Some(func() powValueTypes.NonceRange {
+						start, err := powValueTypes.NewNonce(big.NewInt(480))
+						require.NoError(test, err)
 
-					return value
-				}(),
-				serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
-				hash:              powValueTypes.NewHash(sha256.New()),
-				hashDataLayout: powValueTypes.MustParseHashDataLayout(
-					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
-						":{{ .Challenge.SerializedPayload.ToString }}" +
-						":{{ .Nonce.ToString }}",
-				),
+						end, err := powValueTypes.NewNonce(big.NewInt(600))
+						require.NoError(test, err)
+
+						nonceRange, err := powValueTypes.NewNonceRange(start, end)
+						require.NoError(test, err)
+
+						return nonceRange
+					}()),
+				},
+			},
+			wantNonce: mo.None[int64](),
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrNonceRangeExhausted) &&
+					assert.NotErrorIs(test, err, powErrors.ErrTaskInterruption)
 			},
+		},
+		{
+			name: "error/workers exceed the range size",
 			args: args{
-				ctx: context.Background(),
 				params: SolveParams{
-					RandomInitialNonceParams: mo.Some(powValueTypes.RandomNonceParams{
-						RandomReader: bytes.NewReader([]byte("dummy")),
-						MinRawValue:  big.NewInt(142),
-						MaxRawValue:  big.NewInt(123),
-					}),
+					ConcurrencyFactor: mo.Some(20),
+					NonceRange: mo.Some(func() powValueTypes.NonceRange {
+						start, err := powValueTypes.NewNonce(big.NewInt(480))
+						require.NoError(test, err)
+
+						end, err := powValueTypes.NewNonce(big.NewInt(490))
+						require.NoError(test, err)
+
+						nonceRange, err := powValueTypes.NewNonceRange(start, end)
+						require.NoError(test, err)
+
+						return nonceRange
+					}()),
 				},
 			},
-			want:    Solution{},
-			wantErr: assert.Error,
+			wantNonce: mo.None[int64](),
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrNonceRangeExhausted)
+			},
 		},
 		{
-			name: "error/unable to generate the random initial nonce/I/O error",
-			fields: fields{
-				leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
-					value, err := powValueTypes.NewLeadingZeroBitCount(5)
-					require.NoError(test, err)
+			name: "error/maximal attempt count is exceeded",
+			args: args{
+				params: SolveParams{
+					MaxAttemptCount: mo.Some(10),
+					NonceRange: mo.Some(func() powValueTypes.NonceRange {
+						start, err := powValueTypes.NewNonce(big.NewInt(0))
+						require.NoError(test, err)
 
-					return value
-				}(),
-				serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
-				hash:              powValueTypes.NewHash(sha256.New()),
-				hashDataLayout: powValueTypes.MustParseHashDataLayout(
-					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
-						":{{ .Challenge.SerializedPayload.ToString }}" +
-						":{{ .Nonce.ToString }}",
-				),
+						end, err := powValueTypes.NewNonce(big.NewInt(1000))
+						require.NoError(test, err)
+
+						nonceRange, err := powValueTypes.NewNonceRange(start, end)
+						require.NoError(test, err)
+
+						return nonceRange
+					}()),
+				},
+			},
+			wantNonce: mo.None[int64](),
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrTaskInterruption) &&
+					assert.NotErrorIs(test, err, powErrors.ErrNonceRangeExhausted)
 			},
+		},
+		{
+			name: "error/random initial nonce and nonce range",
 			args: args{
-				ctx: context.Background(),
 				params: SolveParams{
 					RandomInitialNonceParams: mo.Some(powValueTypes.RandomNonceParams{
-						RandomReader: iotest.ErrReader(iotest.ErrTimeout),
+						RandomReader: bytes.NewReader([]byte("dummy")),
 						MinRawValue:  big.NewInt(123),
 						MaxRawValue:  big.NewInt(142),
 					}),
+					NonceRange: mo.Some(func() powValueTypes.NonceRange {
+						start, err := powValueTypes.NewNonce(big.NewInt(0))
+						require.NoError(test, err)
+
+						end, err := powValueTypes.NewNonce(big.NewInt(1000))
+						require.NoError(test, err)
+
+						nonceRange, err := powValueTypes.NewNonceRange(start, end)
+						require.NoError(test, err)
+
+						return nonceRange
+					}()),
 				},
 			},
-			want: Solution{},
-			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
-				return assert.ErrorIs(test, err, powErrors.ErrIO)
-			},
+			wantNonce: mo.None[int64](),
+			wantErr:   assert.Error,
 		},
-		{
-			name: "error/unable to get the target bit index",
-			fields: fields{
-				leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
-					value, err := powValueTypes.NewLeadingZeroBitCount(1000)
-					require.NoError(test, err)
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(10)
+			require.NoError(test, err)
 
-					return value
-				}(),
-				serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
-				hash:              powValueTypes.NewHash(sha256.New()),
+			entity := Challenge{
+				leadingZeroBitCount: leadingZeroBitCount,
+				serializedPayload:   powValueTypes.NewSerializedPayload("dummy"),
+				hash:                powValueTypes.NewHashFromFactory(sha256.New),
 				hashDataLayout: powValueTypes.MustParseHashDataLayout(
 					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
 						":{{ .Challenge.SerializedPayload.ToString }}" +
 						":{{ .Nonce.ToString }}",
 				),
+			}
+			got, err := entity.Solve(context.Background(), data.args.params)
+
+			data.wantErr(test, err)
+			if wantNonce, isPresent := data.wantNonce.Get(); isPresent {
+				assert.Equal(test, big.NewInt(wantNonce), got.Nonce().ToBigInt())
+				assert.NoError(test, got.Verify())
+			}
+		})
+	}
+}
+
+func TestChallenge_Solve_withArbitraryTarget(test *testing.T) {
+	for _, rawTarget := range []int64{1 << 51, 3 << 50, 1 << 50} {
+		test.Run(strconv.FormatInt(rawTarget, 10), func(test *testing.T) {
+			target, err := powValueTypes.NewTarget(
+				big.NewInt(0).Lsh(big.NewInt(rawTarget), 200),
+			)
+			require.NoError(test, err)
+
+			entity, err := NewChallengeBuilder().
+				SetTarget(target).
+				SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+				SetHash(powValueTypes.NewHashFromFactory(sha256.New)).
+				SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+					"{{ .Challenge.SerializedPayload.ToString }}" +
+						":{{ .Nonce.ToString }}",
+				)).
+				Build()
+			require.NoError(test, err)
+
+			got, err := entity.Solve(context.Background(), SolveParams{})
+			require.NoError(test, err)
+
+			hashSum, isPresent := got.HashSum().Get()
+			require.True(test, isPresent)
+
+			rawHashSum := big.NewInt(0).SetBytes(hashSum.ToBytes())
+			assert.Equal(test, -1, rawHashSum.Cmp(target.ToBigInt()))
+			assert.NoError(test, got.Verify())
+		})
+	}
+}
+
+func TestChallenge_Solve_withBinaryLayout(test *testing.T) {
+	createdAt, err := powValueTypes.NewCreatedAt(
+		time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC),
+	)
+	require.NoError(test, err)
+
+	ttl, err := powValueTypes.NewTTL(time.Hour)
+	require.NoError(test, err)
+
+	leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(10)
+	require.NoError(test, err)
+
+	entity, err := NewChallengeBuilder().
+		SetLeadingZeroBitCount(leadingZeroBitCount).
+		SetCreatedAt(createdAt).
+		SetTTL(ttl).
+		SetResource(powValueTypes.NewResource(&url.URL{Path: "/"})).
+		SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+		SetHash(powValueTypes.NewHashFromFactory(sha256.New)).
+		SetHashDataLayout(powValueTypes.NewBinaryHashDataLayout(
+			powValueTypes.MustParseBinaryHashDataLayout(
+				"literal:01,leading-zero-bit-count,created-at,ttl,resource," +
+					"payload,nonce:8",
+			),
+		)).
+		Build()
+	require.NoError(test, err)
+
+	got, err := entity.Solve(context.Background(), SolveParams{})
+	require.NoError(test, err)
+
+	hashSum, isPresent := got.HashSum().Get()
+	require.True(test, isPresent)
+
+	nonce := make([]byte, 8)
+	got.Nonce().ToBigInt().FillBytes(nonce)
+
+	wantHashData := bytes.Join([][]byte{
+		{0x01},
+		{0x00, 0x0a},
+		{0x01, 0x00, 0x00, 0x00, 0x00, 0x38, 0x6e, 0xc0, 0x25},
+		{0x00, 0x00, 0x00, 0x06},
+		{0x01, 0x00, 0x00, 0x03, 0x46, 0x30, 0xb8, 0xa0, 0x00},
+		{0x01, 0x00, 0x00, 0x00, 0x01, '/'},
+		{0x00, 0x00, 0x00, 0x05, 'd', 'u', 'm', 'm', 'y'},
+		nonce,
+	}, nil)
+	wantHashSum := sha256.Sum256(wantHashData)
+
+	assert.Equal(test, wantHashSum[:], hashSum.ToBytes())
+	assert.NoError(test, got.Verify())
+}
+
+func TestChallenge_Solve_withPredefinedLayout(test *testing.T) {
+	type args struct {
+		hashDataLayoutName string
+		withOptionalFields bool
+	}
+
+	createdAt, err := powValueTypes.NewCreatedAt(
+		time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC),
+	)
+	require.NoError(test, err)
+
+	ttl, err := powValueTypes.NewTTL(time.Hour)
+	require.NoError(test, err)
+
+	leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(10)
+	require.NoError(test, err)
+
+	target := "4" + strings.Repeat("0", 61)
+	for _, data := range []struct {
+		name             string
+		args             args
+		wantHashDataHead string
+	}{
+		{
+			name: "v1-colon/with optional fields",
+			args: args{
+				hashDataLayoutName: powValueTypes.HashDataLayoutNameV1Colon,
+				withOptionalFields: true,
 			},
+			wantHashDataHead: "v1-colon:10:" + target +
+				":12000-01-02T03%3A04%3A05.000000006Z" +
+				":11h0m0s" +
+				":1https%3A%2F%2Fexample.com%2F" +
+				":dummy%3Avalue:",
+		},
+		{
+			name: "v1-colon/without optional fields",
 			args: args{
-				ctx:    context.Background(),
-				params: SolveParams{},
+				hashDataLayoutName: powValueTypes.HashDataLayoutNameV1Colon,
+				withOptionalFields: false,
 			},
-			want:    Solution{},
-			wantErr: assert.Error,
+			wantHashDataHead: "v1-colon:10:" + target + ":0:0:0:dummy%3Avalue:",
 		},
 		{
-			name: "error/context is done",
-			fields: fields{
-				leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
-					value, err := powValueTypes.NewLeadingZeroBitCount(5)
-					require.NoError(test, err)
-
-					return value
-				}(),
-				serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
-				hash:              powValueTypes.NewHash(sha256.New()),
-				hashDataLayout: powValueTypes.MustParseHashDataLayout(
-					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
-						":{{ .Challenge.SerializedPayload.ToString }}" +
-						":{{ .Nonce.ToString }}",
-				),
+			name: "v1-length-prefixed/with optional fields",
+			args: args{
+				hashDataLayoutName: powValueTypes.HashDataLayoutNameV1LengthPrefixed,
+				withOptionalFields: true,
 			},
+			wantHashDataHead: "v1-length-prefixed2:1062:" + target +
+				"30:2000-01-02T03:04:05.000000006Z" +
+				"6:1h0m0s" +
+				"20:https://example.com/" +
+				"11:dummy:value",
+		},
+		{
+			name: "v1-length-prefixed/without optional fields",
 			args: args{
-				ctx: func() context.Context {
-					ctx, ctxCancel := context.WithCancel(context.Background())
-					ctxCancel()
+				hashDataLayoutName: powValueTypes.HashDataLayoutNameV1LengthPrefixed,
+				withOptionalFields: false,
+			},
+			wantHashDataHead: "v1-length-prefixed2:1062:" + target +
+				"---11:dummy:value",
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			builder := NewChallengeBuilder().
+				SetLeadingZeroBitCount(leadingZeroBitCount).
+				SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy:value")).
+				SetHash(powValueTypes.NewHashFromFactory(sha256.New)).
+				SetHashDataLayoutByName(data.args.hashDataLayoutName)
+			if data.args.withOptionalFields {
+				builder.
+					SetCreatedAt(createdAt).
+					SetTTL(ttl).
+					SetResource(powValueTypes.NewResource(&url.URL{
+						Scheme: "https",
+						Host:   "example.com",
+						Path:   "/",
+					}))
+			}
 
-					return ctx
-				}(),
-				params: SolveParams{},
+			entity, err := builder.Build()
+			require.NoError(test, err)
+
+			got, err := entity.Solve(context.Background(), SolveParams{})
+			require.NoError(test, err)
+
+			hashSum, isPresent := got.HashSum().Get()
+			require.True(test, isPresent)
+
+			wantHashSum := sha256.Sum256(
+				[]byte(data.wantHashDataHead + got.Nonce().ToString()),
+			)
+
+			assert.Equal(test, wantHashSum[:], hashSum.ToBytes())
+			assert.NoError(test, got.Verify())
+		})
+	}
+}
+
+func TestChallenge_Solve_withNonceEncoding(test *testing.T) {
+	type args struct {
+		rawNonceEncoding  string
+		rawHashDataLayout string
+	}
+
+	leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(10)
+	require.NoError(test, err)
+
+	for _, data := range []struct {
+		name         string
+		args         args
+		wantRawNonce func(nonce powValueTypes.Nonce) string
+	}{
+		{
+			name: "hex/precompiled layout",
+			args: args{
+				rawNonceEncoding: "hex",
+				rawHashDataLayout: "{{ .Challenge.SerializedPayload.ToString }}" +
+					":{{ .Nonce.ToString }}",
 			},
-			want: Solution{},
-			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
-				return assert.ErrorIs(test, err, powErrors.ErrTaskInterruption)
+			wantRawNonce: func(nonce powValueTypes.Nonce) string {
+				return nonce.ToBigInt().Text(16)
 			},
 		},
 		{
-			name: "error/maximal attempt count is exceeded",
-			fields: fields{
-				leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
-					value, err := powValueTypes.NewLeadingZeroBitCount(5)
-					require.NoError(test, err)
-
-					return value
-				}(),
-				serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
-				hash:              powValueTypes.NewHash(sha256.New()),
-				hashDataLayout: powValueTypes.MustParseHashDataLayout(
-					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
-						":{{ .Challenge.SerializedPayload.ToString }}" +
-						":{{ .Nonce.ToString }}",
-				),
-			},
+			name: "hex/executed layout",
 			args: args{
-				ctx: context.Background(),
-				params: SolveParams{
-					MaxAttemptCount: mo.Some(23),
-				},
+				rawNonceEncoding: "hex",
+				rawHashDataLayout: "{{ .Challenge.SerializedPayload.ToString }}" +
+					":{{ .Nonce.ToString | printf \"%s\" }}",
 			},
-			want: Solution{},
-			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
-				return assert.ErrorIs(test, err, powErrors.ErrTaskInterruption)
+			wantRawNonce: func(nonce powValueTypes.Nonce) string {
+				return nonce.ToBigInt().Text(16)
 			},
 		},
 		{
-			name: "error/unable to execute the hash data layout",
-			fields: fields{
-				leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
-					value, err := powValueTypes.NewLeadingZeroBitCount(5)
-					require.NoError(test, err)
-
-					return value
-				}(),
-				serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
-				hash:              powValueTypes.NewHash(sha256.New()),
-				hashDataLayout: powValueTypes.MustParseHashDataLayout(
-					"dummy {{ .Dummy }}",
-				),
+			name: "bytes/precompiled layout",
+			args: args{
+				rawNonceEncoding: "bytes:8",
+				rawHashDataLayout: "{{ .Challenge.SerializedPayload.ToString }}" +
+					":{{ .Nonce.ToString }}",
 			},
+			wantRawNonce: func(nonce powValueTypes.Nonce) string {
+				return string(nonce.ToBigInt().FillBytes(make([]byte, 8)))
+			},
+		},
+		{
+			name: "bytes/executed layout",
 			args: args{
-				ctx:    context.Background(),
-				params: SolveParams{},
+				rawNonceEncoding: "bytes:8",
+				rawHashDataLayout: "{{ .Challenge.SerializedPayload.ToString }}" +
+					":{{ .Nonce.ToString | printf \"%s\" }}",
+			},
+			wantRawNonce: func(nonce powValueTypes.Nonce) string {
+				return string(nonce.ToBigInt().FillBytes(make([]byte, 8)))
 			},
-			want:    Solution{},
-			wantErr: assert.Error,
 		},
 	} {
 		test.Run(data.name, func(test *testing.T) {
-			entity := Challenge{
-				leadingZeroBitCount: data.fields.leadingZeroBitCount,
-				serializedPayload:   data.fields.serializedPayload,
-				hash:                data.fields.hash,
-				hashDataLayout:      data.fields.hashDataLayout,
-			}
-			got, err := entity.Solve(data.args.ctx, data.args.params)
-
-			assert.Equal(test, data.want, got)
-			data.wantErr(test, err)
+			nonceEncoding, err :=
+				powValueTypes.ParseNonceEncoding(data.args.rawNonceEncoding)
+			require.NoError(test, err)
+
+			entity, err := NewChallengeBuilder().
+				SetLeadingZeroBitCount(leadingZeroBitCount).
+				SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+				SetHash(powValueTypes.NewHashFromFactory(sha256.New)).
+				SetHashDataLayout(
+					powValueTypes.MustParseHashDataLayout(data.args.rawHashDataLayout),
+				).
+				SetNonceEncoding(nonceEncoding).
+				Build()
+			require.NoError(test, err)
+
+			got, err := entity.Solve(context.Background(), SolveParams{})
+			require.NoError(test, err)
+
+			hashSum, isPresent := got.HashSum().Get()
+			require.True(test, isPresent)
+
+			wantHashSum := sha256.Sum256([]byte(
+				"dummy:" + data.wantRawNonce(got.Nonce()),
+			))
+
+			assert.Equal(test, wantHashSum[:], hashSum.ToBytes())
+			assert.NoError(test, got.Verify())
 		})
 	}
 }
+
+func TestChallenge_Solve_withBinaryLayoutOverflow(test *testing.T) {
+	leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(32)
+	require.NoError(test, err)
+
+	entity, err := NewChallengeBuilder().
+		SetLeadingZeroBitCount(leadingZeroBitCount).
+		SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+		SetHash(powValueTypes.NewHashFromFactory(sha256.New)).
+		SetHashDataLayout(powValueTypes.NewBinaryHashDataLayout(
+			powValueTypes.MustParseBinaryHashDataLayout("payload,nonce:1"),
+		)).
+		Build()
+	require.NoError(test, err)
+
+	// the nonce exceeds the field width after all its values are tried
+	_, err = entity.Solve(context.Background(), SolveParams{})
+
+	assert.Error(test, err)
+	assert.NotErrorIs(test, err, powErrors.ErrTaskInterruption)
+}
@@ -0,0 +1,40 @@
+package pow
+
+import (
+	"errors"
+
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+type SolveCheckpoint struct {
+	// each worker searches its own part of the nonce space,
+	// so the next nonce is stored for each of them
+	WorkerNextNonces []powValueTypes.Nonce
+	AttemptCount     int
+}
+
+func (checkpoint SolveCheckpoint) validate() error {
+	var errs []error
+	if len(checkpoint.WorkerNextNonces) == 0 {
+		errs = append(errs, errors.New("at least one worker nonce is required"))
+	}
+	if checkpoint.AttemptCount < 0 {
+		errs = append(errs, errors.New("attempt count cannot be negative"))
+	}
+
+	return errors.Join(errs...)
+}
+
+type InterruptionError struct {
+	Checkpoint SolveCheckpoint
+
+	err error
+}
+
+func (err InterruptionError) Error() string {
+	return err.err.Error()
+}
+
+func (err InterruptionError) Unwrap() error {
+	return err.err
+}
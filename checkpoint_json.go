@@ -0,0 +1,70 @@
+package pow
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+type solveCheckpointJSONModel struct {
+	WorkerNextNonces []string `json:"worker_next_nonces"`
+	AttemptCount     int      `json:"attempt_count"`
+}
+
+func (checkpoint SolveCheckpoint) MarshalJSON() ([]byte, error) {
+	model := solveCheckpointJSONModel{
+		WorkerNextNonces: make([]string, 0, len(checkpoint.WorkerNextNonces)),
+		AttemptCount:     checkpoint.AttemptCount,
+	}
+	// the nonces are always decimal, regardless of the nonce encoding
+	// of the challenge, so they are parsed back in the same way
+	for _, nonce := range checkpoint.WorkerNextNonces {
+		model.WorkerNextNonces = append(
+			model.WorkerNextNonces,
+			powValueTypes.DefaultNonceEncoding.Encode(nonce),
+		)
+	}
+
+	data, err := json.Marshal(model)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal the checkpoint model: %w", err)
+	}
+
+	return data, nil
+}
+
+func (checkpoint *SolveCheckpoint) UnmarshalJSON(data []byte) error {
+	var model solveCheckpointJSONModel
+	if err := json.Unmarshal(data, &model); err != nil {
+		return fmt.Errorf("unable to unmarshal the checkpoint model: %w", err)
+	}
+
+	var errs []error
+	workerNextNonces :=
+		make([]powValueTypes.Nonce, 0, len(model.WorkerNextNonces))
+	for _, rawNonce := range model.WorkerNextNonces {
+		nonce, err := powValueTypes.ParseNonce(rawNonce)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to parse the nonce: %w", err))
+			continue
+		}
+
+		workerNextNonces = append(workerNextNonces, nonce)
+	}
+	if len(errs) > 0 {
+		return errors.Join(errs...)
+	}
+
+	unmarshalledCheckpoint := SolveCheckpoint{
+		WorkerNextNonces: workerNextNonces,
+		AttemptCount:     model.AttemptCount,
+	}
+	if err := unmarshalledCheckpoint.validate(); err != nil {
+		return fmt.Errorf("unable to validate the checkpoint: %w", err)
+	}
+
+	*checkpoint = unmarshalledCheckpoint
+	return nil
+}
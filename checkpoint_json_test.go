@@ -0,0 +1,113 @@
+package pow
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+func TestSolveCheckpoint_MarshalJSON(test *testing.T) {
+	checkpoint := SolveCheckpoint{
+		WorkerNextNonces: []powValueTypes.Nonce{
+			func() powValueTypes.Nonce {
+				value, err := powValueTypes.NewNonce(big.NewInt(23))
+				require.NoError(test, err)
+
+				return value
+			}(),
+			func() powValueTypes.Nonce {
+				value, err := powValueTypes.NewNonce(big.NewInt(42))
+				require.NoError(test, err)
+
+				encoding, err :=
+					powValueTypes.NewNonceEncoding(powValueTypes.NonceEncodingKindHex)
+				require.NoError(test, err)
+
+				return value.WithEncoding(encoding)
+			}(),
+		},
+		AttemptCount: 100,
+	}
+	got, err := json.Marshal(checkpoint)
+
+	require.NoError(test, err)
+	assert.JSONEq(
+		test,
+		`{"worker_next_nonces": ["23", "42"], "attempt_count": 100}`,
+		string(got),
+	)
+}
+
+func TestSolveCheckpoint_UnmarshalJSON(test *testing.T) {
+	type args struct {
+		data string
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		want    SolveCheckpoint
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				data: `{"worker_next_nonces": ["23", "42"], "attempt_count": 100}`,
+			},
+			want: SolveCheckpoint{
+				WorkerNextNonces: []powValueTypes.Nonce{
+					func() powValueTypes.Nonce {
+						value, err := powValueTypes.NewNonce(big.NewInt(23))
+						require.NoError(test, err)
+
+						return value
+					}(),
+					func() powValueTypes.Nonce {
+						value, err := powValueTypes.NewNonce(big.NewInt(42))
+						require.NoError(test, err)
+
+						return value
+					}(),
+				},
+				AttemptCount: 100,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/invalid JSON",
+			args: args{
+				data: `invalid`,
+			},
+			want:    SolveCheckpoint{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/invalid nonce",
+			args: args{
+				data: `{"worker_next_nonces": ["23", "-42"], "attempt_count": 100}`,
+			},
+			want:    SolveCheckpoint{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/invalid checkpoint",
+			args: args{
+				data: `{"worker_next_nonces": [], "attempt_count": 100}`,
+			},
+			want:    SolveCheckpoint{},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			var got SolveCheckpoint
+			err := json.Unmarshal([]byte(data.args.data), &got)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
@@ -0,0 +1,294 @@
+package pow
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/samber/mo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	powErrors "github.com/thewizardplusplus/go-pow/errors"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+func TestSolveCheckpoint_validate(test *testing.T) {
+	type fields struct {
+		WorkerNextNonces []powValueTypes.Nonce
+		AttemptCount     int
+	}
+
+	for _, data := range []struct {
+		name    string
+		fields  fields
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			fields: fields{
+				WorkerNextNonces: []powValueTypes.Nonce{
+					func() powValueTypes.Nonce {
+						value, err := powValueTypes.NewNonce(big.NewInt(23))
+						require.NoError(test, err)
+
+						return value
+					}(),
+					func() powValueTypes.Nonce {
+						value, err := powValueTypes.NewNonce(big.NewInt(42))
+						require.NoError(test, err)
+
+						return value
+					}(),
+				},
+				AttemptCount: 100,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/no worker nonces",
+			fields: fields{
+				WorkerNextNonces: nil,
+				AttemptCount:     100,
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/negative attempt count",
+			fields: fields{
+				WorkerNextNonces: []powValueTypes.Nonce{
+					func() powValueTypes.Nonce {
+						value, err := powValueTypes.NewNonce(big.NewInt(23))
+						require.NoError(test, err)
+
+						return value
+					}(),
+				},
+				AttemptCount: -100,
+			},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			checkpoint := SolveCheckpoint{
+				WorkerNextNonces: data.fields.WorkerNextNonces,
+				AttemptCount:     data.fields.AttemptCount,
+			}
+			err := checkpoint.validate()
+
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestChallenge_Solve_withCheckpoint(test *testing.T) {
+	for _, data := range []struct {
+		name              string
+		concurrencyFactor int
+	}{
+		{
+			name:              "success/single worker",
+			concurrencyFactor: 1,
+		},
+		{
+			name:              "success/several workers",
+			concurrencyFactor: 4,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(10)
+			require.NoError(test, err)
+
+			entity := Challenge{
+				leadingZeroBitCount: leadingZeroBitCount,
+				serializedPayload:   powValueTypes.NewSerializedPayload("dummy"),
+				hash:                powValueTypes.NewHashFromFactory(sha256.New),
+				hashDataLayout: powValueTypes.MustParseHashDataLayout(
+					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+						":{{ .Challenge.SerializedPayload.ToString }}" +
+						":{{ .Nonce.ToString }}",
+				),
+			}
+
+			_, err = entity.Solve(context.Background(), SolveParams{
+				MaxAttemptCount:   mo.Some(100),
+				ConcurrencyFactor: mo.Some(data.concurrencyFactor),
+			})
+
+			var interruptionErr InterruptionError
+			require.ErrorAs(test, err, &interruptionErr)
+			require.ErrorIs(test, err, powErrors.ErrTaskInterruption)
+
+			checkpoint := interruptionErr.Checkpoint
+			require.Len(test, checkpoint.WorkerNextNonces, data.concurrencyFactor)
+			require.Equal(test, 100, checkpoint.AttemptCount)
+
+			// the workers process the residue classes of the nonce space,
+			// and each of them stops at the first untried nonce, so the sum
+			// of the next nonces is determined by the total attempt count
+			nextNonceSum := big.NewInt(0)
+			for workerIndex, nonce := range checkpoint.WorkerNextNonces {
+				residue := big.NewInt(0).Mod(
+					nonce.ToBigInt(),
+					big.NewInt(int64(data.concurrencyFactor)),
+				)
+				assert.Equal(test, int64(workerIndex), residue.Int64())
+
+				nextNonceSum.Add(nextNonceSum, nonce.ToBigInt())
+			}
+			wantNextNonceSum := big.NewInt(int64(
+				data.concurrencyFactor*100 +
+					(data.concurrencyFactor-1)*data.concurrencyFactor/2,
+			))
+			assert.Equal(test, wantNextNonceSum, nextNonceSum)
+
+			got, err := entity.Solve(context.Background(), SolveParams{
+				Checkpoint: mo.Some(checkpoint),
+			})
+			require.NoError(test, err)
+
+			assert.True(test, got.Nonce().ToBigInt().Cmp(big.NewInt(100)) >= 0)
+			assert.NoError(test, got.Verify())
+		})
+	}
+}
+
+func TestChallenge_Solve_withCheckpointOnCancellation(test *testing.T) {
+	leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(10)
+	require.NoError(test, err)
+
+	entity := Challenge{
+		leadingZeroBitCount: leadingZeroBitCount,
+		serializedPayload:   powValueTypes.NewSerializedPayload("dummy"),
+		hash:                powValueTypes.NewHashFromFactory(sha256.New),
+		hashDataLayout: powValueTypes.MustParseHashDataLayout(
+			"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+				":{{ .Challenge.SerializedPayload.ToString }}" +
+				":{{ .Nonce.ToString }}",
+		),
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = entity.Solve(ctx, SolveParams{
+		ConcurrencyFactor: mo.Some(2),
+		Checkpoint: mo.Some(SolveCheckpoint{
+			WorkerNextNonces: []powValueTypes.Nonce{
+				func() powValueTypes.Nonce {
+					value, err := powValueTypes.NewNonce(big.NewInt(100))
+					require.NoError(test, err)
+
+					return value
+				}(),
+				func() powValueTypes.Nonce {
+					value, err := powValueTypes.NewNonce(big.NewInt(101))
+					require.NoError(test, err)
+
+					return value
+				}(),
+			},
+			AttemptCount: 100,
+		}),
+	})
+
+	var interruptionErr InterruptionError
+	require.ErrorAs(test, err, &interruptionErr)
+	assert.ErrorIs(test, err, context.Canceled)
+	assert.Equal(
+		test,
+		SolveCheckpoint{
+			WorkerNextNonces: []powValueTypes.Nonce{
+				func() powValueTypes.Nonce {
+					value, err := powValueTypes.NewNonce(big.NewInt(100))
+					require.NoError(test, err)
+
+					return value
+				}(),
+				func() powValueTypes.Nonce {
+					value, err := powValueTypes.NewNonce(big.NewInt(101))
+					require.NoError(test, err)
+
+					return value
+				}(),
+			},
+			AttemptCount: 100,
+		},
+		interruptionErr.Checkpoint,
+	)
+}
+
+func TestChallenge_Solve_withInvalidCheckpoint(test *testing.T) {
+	for _, data := range []struct {
+		name   string
+		params SolveParams
+	}{
+		{
+			name: "error/invalid checkpoint",
+			params: SolveParams{
+				Checkpoint: mo.Some(SolveCheckpoint{}),
+			},
+		},
+		{
+			name: "error/concurrency factor doesn't match the checkpoint",
+			params: SolveParams{
+				ConcurrencyFactor: mo.Some(2),
+				Checkpoint: mo.Some(SolveCheckpoint{
+					WorkerNextNonces: []powValueTypes.Nonce{
+						func() powValueTypes.Nonce {
+							value, err := powValueTypes.NewNonce(big.NewInt(100))
+							require.NoError(test, err)
+
+							return value
+						}(),
+					},
+				}),
+			},
+		},
+		{
+			name: "error/random initial nonce and checkpoint",
+			params: SolveParams{
+				RandomInitialNonceParams: mo.Some(powValueTypes.RandomNonceParams{
+					RandomReader: bytes.NewReader([]byte("dummy")),
+					MinRawValue:  big.NewInt(123),
+					MaxRawValue:  big.NewInt(142),
+				}),
+				Checkpoint: mo.Some(SolveCheckpoint{
+					WorkerNextNonces: []powValueTypes.Nonce{
+						func() powValueTypes.Nonce {
+							value, err := powValueTypes.NewNonce(big.NewInt(100))
+							require.NoError(test, err)
+
+							return value
+						}(),
+					},
+				}),
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(10)
+			require.NoError(test, err)
+
+			entity := Challenge{
+				leadingZeroBitCount: leadingZeroBitCount,
+				serializedPayload:   powValueTypes.NewSerializedPayload("dummy"),
+				hash:                powValueTypes.NewHashFromFactory(sha256.New),
+				hashDataLayout: powValueTypes.MustParseHashDataLayout(
+					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+						":{{ .Challenge.SerializedPayload.ToString }}" +
+						":{{ .Nonce.ToString }}",
+				),
+			}
+
+			_, err = entity.Solve(context.Background(), data.params)
+
+			require.Error(test, err)
+
+			var interruptionErr InterruptionError
+			assert.False(test, errors.As(err, &interruptionErr))
+		})
+	}
+}
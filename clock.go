@@ -0,0 +1,29 @@
+package pow
+
+import (
+	"time"
+)
+
+type Clock interface {
+	Now() time.Time
+}
+
+type SystemClock struct{}
+
+func (clock SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+type FixedClock struct {
+	moment time.Time
+}
+
+func NewFixedClock(moment time.Time) FixedClock {
+	return FixedClock{
+		moment: moment,
+	}
+}
+
+func (clock FixedClock) Now() time.Time {
+	return clock.moment
+}
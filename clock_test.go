@@ -0,0 +1,72 @@
+package pow
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSystemClock_Now(test *testing.T) {
+	clock := SystemClock{}
+	got := clock.Now()
+
+	assert.WithinDuration(test, time.Now(), got, time.Minute)
+}
+
+func TestNewFixedClock(test *testing.T) {
+	type args struct {
+		moment time.Time
+	}
+
+	for _, data := range []struct {
+		name string
+		args args
+		want FixedClock
+	}{
+		{
+			name: "success",
+			args: args{
+				moment: time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC),
+			},
+			want: FixedClock{
+				moment: time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC),
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got := NewFixedClock(data.args.moment)
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
+func TestFixedClock_Now(test *testing.T) {
+	type fields struct {
+		moment time.Time
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		want   time.Time
+	}{
+		{
+			name: "success",
+			fields: fields{
+				moment: time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC),
+			},
+			want: time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC),
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			clock := FixedClock{
+				moment: data.fields.moment,
+			}
+			got := clock.Now()
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
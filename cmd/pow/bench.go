@@ -0,0 +1,135 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/samber/mo"
+	powCalibration "github.com/thewizardplusplus/go-pow/calibration"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+type benchmarkResult struct {
+	HashName       string                              `json:"hash_name"`
+	AttemptCount   int                                 `json:"attempt_count"`
+	Elapsed        string                              `json:"elapsed"`
+	HashRate       float64                             `json:"hash_rate"`
+	Recommendation mo.Option[difficultyRecommendation] `json:"recommendation"`
+}
+
+type difficultyRecommendation struct {
+	MedianSolveTime     string `json:"median_solve_time"`
+	LeadingZeroBitCount int    `json:"leading_zero_bit_count"`
+	Target              string `json:"target"`
+}
+
+func runBenchCommand(args []string, stdin io.Reader, stdout io.Writer) error {
+	var (
+		hashName          string
+		hashDataLayout    string
+		attemptCount      int
+		concurrencyFactor int
+		medianSolveTime   time.Duration
+	)
+	flagSet := newFlagSet("bench")
+	flagSet.StringVar(&hashName, "hash", defaultHashName, "hash name")
+	flagSet.StringVar(
+		&hashDataLayout,
+		"layout",
+		defaultHashDataLayout,
+		"hash data layout",
+	)
+	flagSet.IntVar(&attemptCount, "attempts", 100000, "number of attempts")
+	flagSet.IntVar(
+		&concurrencyFactor,
+		"concurrency",
+		1,
+		"number of goroutines used for solving",
+	)
+	flagSet.DurationVar(
+		&medianSolveTime,
+		"median-solve-time",
+		0,
+		"desired median solve time to recommend the difficulty for",
+	)
+	if err := flagSet.Parse(args); err != nil {
+		return fmt.Errorf("unable to parse the flags: %w", err)
+	}
+	if attemptCount <= 0 {
+		return errors.New("number of attempts should be positive")
+	}
+
+	hash, err := powValueTypes.DefaultHashRegistry.Lookup(hashName)
+	if err != nil {
+		return fmt.Errorf("unable to look up the hash: %w", err)
+	}
+
+	parsedHashDataLayout, err := powValueTypes.ParseHashDataLayout(hashDataLayout)
+	if err != nil {
+		return fmt.Errorf("unable to parse the hash data layout: %w", err)
+	}
+
+	benchmark, err := powCalibration.Benchmark(
+		context.Background(),
+		powCalibration.BenchmarkParams{
+			Hash:              hash,
+			HashDataLayout:    parsedHashDataLayout,
+			MaxAttemptCount:   mo.Some(attemptCount),
+			ConcurrencyFactor: mo.Some(concurrencyFactor),
+		},
+	)
+	if err != nil {
+		return fmt.Errorf("unable to benchmark the hash: %w", err)
+	}
+
+	result := benchmarkResult{
+		HashName:     hashName,
+		AttemptCount: benchmark.AttemptCount,
+		Elapsed:      benchmark.Elapsed.String(),
+		HashRate:     benchmark.Device.HashRate(),
+	}
+	if medianSolveTime != 0 {
+		recommendation, err :=
+			makeDifficultyRecommendation(benchmark.Device, hash, medianSolveTime)
+		if err != nil {
+			return fmt.Errorf("unable to recommend the difficulty: %w", err)
+		}
+
+		result.Recommendation = mo.Some(recommendation)
+	}
+
+	return writeJSON(stdout, result)
+}
+
+func makeDifficultyRecommendation(
+	device powCalibration.Device,
+	hash powValueTypes.Hash,
+	medianSolveTime time.Duration,
+) (difficultyRecommendation, error) {
+	leadingZeroBitCount, err :=
+		device.RecommendLeadingZeroBitCount(hash, medianSolveTime)
+	if err != nil {
+		return difficultyRecommendation{}, fmt.Errorf(
+			"unable to recommend the leading zero bit count: %w",
+			err,
+		)
+	}
+
+	target, err := device.RecommendTarget(hash, medianSolveTime)
+	if err != nil {
+		return difficultyRecommendation{}, fmt.Errorf(
+			"unable to recommend the target: %w",
+			err,
+		)
+	}
+
+	recommendation := difficultyRecommendation{
+		MedianSolveTime:     medianSolveTime.String(),
+		LeadingZeroBitCount: leadingZeroBitCount.ToInt(),
+		Target:              target.ToString(),
+	}
+	return recommendation, nil
+}
@@ -0,0 +1,30 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+)
+
+func newFlagSet(name string) *flag.FlagSet {
+	return flag.NewFlagSet("pow "+name, flag.ContinueOnError)
+}
+
+func readJSON(reader io.Reader, value any) error {
+	if err := json.NewDecoder(reader).Decode(value); err != nil {
+		return fmt.Errorf("unable to decode the JSON: %w", err)
+	}
+
+	return nil
+}
+
+func writeJSON(writer io.Writer, value any) error {
+	encoder := json.NewEncoder(writer)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(value); err != nil {
+		return fmt.Errorf("unable to encode the JSON: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,284 @@
+package main
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	pow "github.com/thewizardplusplus/go-pow"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+const (
+	defaultHashName       = "SHA-256"
+	defaultHashDataLayout = "{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+		":{{ .Challenge.SerializedPayload.ToString }}" +
+		":{{ .Nonce.ToString }}"
+	randomPayloadSize = 16
+)
+
+type challengeFlags struct {
+	leadingZeroBitCount int
+	targetBitIndex      int
+	target              string
+	difficulty          float64
+	createdAt           string
+	ttl                 time.Duration
+	resource            string
+	serializedPayload   string
+	hashName            string
+	hashDataLayout      string
+	hashDataLayoutKind  string
+	hashDataLayoutName  string
+	nonceEncoding       string
+}
+
+func runIssueCommand(args []string, stdin io.Reader, stdout io.Writer) error {
+	var flags challengeFlags
+	flagSet := newFlagSet("issue")
+	flagSet.IntVar(
+		&flags.leadingZeroBitCount,
+		"leading-zero-bit-count",
+		-1,
+		"required number of leading zero bits in the resulting hash",
+	)
+	flagSet.IntVar(
+		&flags.targetBitIndex,
+		"target-bit-index",
+		-1,
+		"bit index of the target (an alternative to the leading zero bit count)",
+	)
+	flagSet.StringVar(
+		&flags.target,
+		"target",
+		"",
+		"arbitrary target as a hex number (an alternative to the leading zero bit "+
+			"count)",
+	)
+	flagSet.Float64Var(
+		&flags.difficulty,
+		"difficulty",
+		0,
+		"fractional difficulty relative to the maximal target (an alternative "+
+			"to the leading zero bit count)",
+	)
+	flagSet.StringVar(
+		&flags.createdAt,
+		"created-at",
+		"",
+		"creation timestamp in RFC 3339 format (the current time by default, "+
+			"if the TTL is specified)",
+	)
+	flagSet.DurationVar(&flags.ttl, "ttl", 0, "TTL of the challenge")
+	flagSet.StringVar(&flags.resource, "resource", "", "resource URI")
+	flagSet.StringVar(
+		&flags.serializedPayload,
+		"payload",
+		"",
+		"serialized payload (random by default)",
+	)
+	flagSet.StringVar(&flags.hashName, "hash", defaultHashName, "hash name")
+	flagSet.StringVar(
+		&flags.hashDataLayout,
+		"layout",
+		defaultHashDataLayout,
+		"hash data layout",
+	)
+	flagSet.StringVar(
+		&flags.hashDataLayoutKind,
+		"layout-kind",
+		string(powValueTypes.HashDataLayoutKindText),
+		"kind of the hash data layout (text or binary)",
+	)
+	flagSet.StringVar(
+		&flags.hashDataLayoutName,
+		"layout-name",
+		"",
+		"name of a predefined hash data layout (overrides the layout; one of "+
+			strings.Join(powValueTypes.PredefinedHashDataLayoutNames(), ", ")+
+			")",
+	)
+	flagSet.StringVar(
+		&flags.nonceEncoding,
+		"nonce-encoding",
+		"",
+		"encoding of the nonce in the hash data (decimal, hex, base36, base64url "+
+			"or bytes:<width>; decimal by default)",
+	)
+	if err := flagSet.Parse(args); err != nil {
+		return fmt.Errorf("unable to parse the flags: %w", err)
+	}
+
+	challenge, err := buildChallenge(flags)
+	if err != nil {
+		return fmt.Errorf("unable to build the challenge: %w", err)
+	}
+
+	return writeJSON(stdout, challenge)
+}
+
+func buildChallenge(flags challengeFlags) (pow.Challenge, error) {
+	var errs []error
+	builder := pow.NewChallengeBuilder()
+
+	if flags.leadingZeroBitCount >= 0 {
+		leadingZeroBitCount, err :=
+			powValueTypes.NewLeadingZeroBitCount(flags.leadingZeroBitCount)
+		if err != nil {
+			errs = append(
+				errs,
+				fmt.Errorf("unable to construct the leading zero bit count: %w", err),
+			)
+		} else {
+			builder.SetLeadingZeroBitCount(leadingZeroBitCount)
+		}
+	}
+
+	if flags.targetBitIndex >= 0 {
+		targetBitIndex, err :=
+			powValueTypes.NewTargetBitIndex(flags.targetBitIndex)
+		if err != nil {
+			errs = append(
+				errs,
+				fmt.Errorf("unable to construct the target bit index: %w", err),
+			)
+		} else {
+			builder.SetTargetBitIndex(targetBitIndex)
+		}
+	}
+
+	if flags.target != "" && flags.difficulty != 0 {
+		errs = append(
+			errs,
+			errors.New("target and difficulty are specified at the same time"),
+		)
+	} else if flags.target != "" {
+		target, err := powValueTypes.ParseTarget(flags.target)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to parse the target: %w", err))
+		} else {
+			builder.SetTarget(target)
+		}
+	}
+
+	if flags.createdAt != "" || flags.ttl != 0 {
+		createdAt, err := parseCreatedAt(flags.createdAt)
+		if err != nil {
+			errs = append(
+				errs,
+				fmt.Errorf("unable to parse the `CreatedAt` timestamp: %w", err),
+			)
+		} else {
+			builder.SetCreatedAt(createdAt)
+		}
+
+		ttl, err := powValueTypes.NewTTL(flags.ttl)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to construct the TTL: %w", err))
+		} else {
+			builder.SetTTL(ttl)
+		}
+	}
+
+	if flags.resource != "" {
+		resource, err := powValueTypes.ParseResource(flags.resource)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to parse the resource: %w", err))
+		} else {
+			builder.SetResource(resource)
+		}
+	}
+
+	serializedPayload := flags.serializedPayload
+	if serializedPayload == "" {
+		var err error
+		serializedPayload, err = makeRandomPayload()
+		if err != nil {
+			errs = append(
+				errs,
+				fmt.Errorf("unable to make the random payload: %w", err),
+			)
+		}
+	}
+	builder.SetSerializedPayload(
+		powValueTypes.NewSerializedPayload(serializedPayload),
+	)
+
+	hash, err := powValueTypes.DefaultHashRegistry.Lookup(flags.hashName)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("unable to look up the hash: %w", err))
+	} else {
+		builder.SetHash(hash)
+
+		// the difficulty is relative to the hash checksum size,
+		// so it can be converted to the target only after the hash is known
+		if flags.difficulty != 0 {
+			target, err :=
+				powValueTypes.NewTargetFromDifficulty(hash.SizeInBits(), flags.difficulty)
+			if err != nil {
+				errs = append(
+					errs,
+					fmt.Errorf("unable to construct the target: %w", err),
+				)
+			} else {
+				builder.SetTarget(target)
+			}
+		}
+	}
+
+	if flags.hashDataLayoutName != "" {
+		builder.SetHashDataLayoutByName(flags.hashDataLayoutName)
+	} else {
+		hashDataLayout, err := powValueTypes.ParseHashDataLayoutOfKind(
+			powValueTypes.HashDataLayoutKind(flags.hashDataLayoutKind),
+			flags.hashDataLayout,
+		)
+		if err != nil {
+			errs = append(
+				errs,
+				fmt.Errorf("unable to parse the hash data layout: %w", err),
+			)
+		} else {
+			builder.SetHashDataLayout(hashDataLayout)
+		}
+	}
+
+	if flags.nonceEncoding != "" {
+		nonceEncoding, err := powValueTypes.ParseNonceEncoding(flags.nonceEncoding)
+		if err != nil {
+			errs = append(
+				errs,
+				fmt.Errorf("unable to parse the nonce encoding: %w", err),
+			)
+		} else {
+			builder.SetNonceEncoding(nonceEncoding)
+		}
+	}
+
+	if len(errs) > 0 {
+		return pow.Challenge{}, errors.Join(errs...)
+	}
+
+	return builder.Build()
+}
+
+func parseCreatedAt(rawValue string) (powValueTypes.CreatedAt, error) {
+	if rawValue == "" {
+		return powValueTypes.NewCreatedAt(time.Now())
+	}
+
+	return powValueTypes.ParseCreatedAt(rawValue)
+}
+
+func makeRandomPayload() (string, error) {
+	payload := make([]byte, randomPayloadSize)
+	if _, err := rand.Read(payload); err != nil {
+		return "", fmt.Errorf("unable to read the random bytes: %w", err)
+	}
+
+	return hex.EncodeToString(payload), nil
+}
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+)
+
+type command struct {
+	name        string
+	description string
+	run         func(args []string, stdin io.Reader, stdout io.Writer) error
+}
+
+var commands = []command{
+	{
+		name:        "issue",
+		description: "build a challenge and write it to stdout",
+		run:         runIssueCommand,
+	},
+	{
+		name:        "solve",
+		description: "read a challenge from stdin and write its solution to stdout",
+		run:         runSolveCommand,
+	},
+	{
+		name:        "verify",
+		description: "read a solution from stdin and write the verdict to stdout",
+		run:         runVerifyCommand,
+	},
+	{
+		name:        "bench",
+		description: "measure the hash rate and write the result to stdout",
+		run:         runBenchCommand,
+	},
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("pow: ")
+
+	if err := run(os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer) error {
+	if len(args) == 0 {
+		return errors.New(makeUsage())
+	}
+
+	for _, command := range commands {
+		if command.name == args[0] {
+			if err := command.run(args[1:], stdin, stdout); err != nil {
+				return fmt.Errorf("unable to run the %q command: %w", args[0], err)
+			}
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("unknown command %q\n%s", args[0], makeUsage())
+}
+
+func makeUsage() string {
+	usage := "usage: pow <command> [flags]\n\ncommands:"
+	for _, command := range commands {
+		usage += fmt.Sprintf("\n  %-8s %s", command.name, command.description)
+	}
+
+	return usage
+}
@@ -0,0 +1,387 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRun(test *testing.T) {
+	type args struct {
+		args  []string
+		stdin string
+	}
+
+	for _, data := range []struct {
+		name       string
+		args       args
+		wantStdout string
+		wantErr    assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/issue",
+			args: args{
+				args: []string{
+					"issue",
+					"-leading-zero-bit-count", "5",
+					"-created-at", "2000-01-02T03:04:05.000000006Z",
+					"-ttl", "1h",
+					"-resource", "https://example.com/",
+					"-payload", "dummy",
+				},
+			},
+			wantStdout: `{
+				"leading_zero_bit_count": 5,
+				"target": null,
+				"created_at": "2000-01-02T03:04:05.000000006Z",
+				"ttl": "1h0m0s",
+				"resource": "https://example.com/",
+				"serialized_payload": "dummy",
+				"hash_name": "SHA-256",
+				"hash_data_layout": "{{.Challenge.LeadingZeroBitCount.ToInt}}` +
+				`:{{.Challenge.SerializedPayload.ToString}}:{{.Nonce.ToString}}",
+				"hash_data_layout_kind": "text",
+				"nonce_encoding": null,
+				"signature": null,
+				"required_hash_data_fields": null
+			}`,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/issue with a difficulty",
+			args: args{
+				args: []string{
+					"issue",
+					"-difficulty", "48",
+					"-payload", "dummy",
+					"-layout", "{{ .Challenge.SerializedPayload.ToString }}" +
+						":{{ .Nonce.ToString }}",
+				},
+			},
+			wantStdout: `{
+				"leading_zero_bit_count": 5,
+				"target": "555555555555555555555555555555555555555555555555555555555555555",
+				"created_at": null,
+				"ttl": null,
+				"resource": null,
+				"serialized_payload": "dummy",
+				"hash_name": "SHA-256",
+				"hash_data_layout": "{{.Challenge.SerializedPayload.ToString}}` +
+				`:{{.Nonce.ToString}}",
+				"hash_data_layout_kind": "text",
+				"nonce_encoding": null,
+				"signature": null,
+				"required_hash_data_fields": null
+			}`,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/issue with a binary layout",
+			args: args{
+				args: []string{
+					"issue",
+					"-leading-zero-bit-count", "5",
+					"-payload", "dummy",
+					"-layout-kind", "binary",
+					"-layout", "payload, nonce:8",
+				},
+			},
+			wantStdout: `{
+				"leading_zero_bit_count": 5,
+				"target": null,
+				"created_at": null,
+				"ttl": null,
+				"resource": null,
+				"serialized_payload": "dummy",
+				"hash_name": "SHA-256",
+				"hash_data_layout": "payload,nonce:8",
+				"hash_data_layout_kind": "binary",
+				"nonce_encoding": null,
+				"signature": null,
+				"required_hash_data_fields": null
+			}`,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/issue with a predefined layout",
+			args: args{
+				args: []string{
+					"issue",
+					"-leading-zero-bit-count", "5",
+					"-payload", "dummy",
+					"-layout-name", "v1-length-prefixed",
+				},
+			},
+			wantStdout: `{
+				"leading_zero_bit_count": 5,
+				"target": null,
+				"created_at": null,
+				"ttl": null,
+				"resource": null,
+				"serialized_payload": "dummy",
+				"hash_name": "SHA-256",
+				"hash_data_layout": "v1-length-prefixed` +
+				`{{print .Challenge.LeadingZeroBitCount.ToInt | lengthPrefix}}` +
+				`{{lengthPrefix .Challenge.Target.ToString}}` +
+				`{{if .Challenge.CreatedAt.IsPresent}}` +
+				`{{lengthPrefix .Challenge.CreatedAt.MustGet.ToString}}` +
+				`{{else}}-{{end}}` +
+				`{{if .Challenge.TTL.IsPresent}}` +
+				`{{lengthPrefix .Challenge.TTL.MustGet.ToString}}` +
+				`{{else}}-{{end}}` +
+				`{{if .Challenge.Resource.IsPresent}}` +
+				`{{lengthPrefix .Challenge.Resource.MustGet.ToString}}` +
+				`{{else}}-{{end}}` +
+				`{{lengthPrefix .Challenge.SerializedPayload.ToString}}` +
+				`{{.Nonce.ToString}}",
+				"hash_data_layout_kind": "text",
+				"nonce_encoding": null,
+				"signature": null,
+				"required_hash_data_fields": null
+			}`,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/issue with a nonce encoding",
+			args: args{
+				args: []string{
+					"issue",
+					"-leading-zero-bit-count", "5",
+					"-payload", "dummy",
+					"-nonce-encoding", "bytes:8",
+				},
+			},
+			wantStdout: `{
+				"leading_zero_bit_count": 5,
+				"target": null,
+				"created_at": null,
+				"ttl": null,
+				"resource": null,
+				"serialized_payload": "dummy",
+				"hash_name": "SHA-256",
+				"hash_data_layout": "{{.Challenge.LeadingZeroBitCount.ToInt}}` +
+				`:{{.Challenge.SerializedPayload.ToString}}:{{.Nonce.ToString}}",
+				"hash_data_layout_kind": "text",
+				"nonce_encoding": "bytes:8",
+				"signature": null,
+				"required_hash_data_fields": null
+			}`,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/solve",
+			args: args{
+				args: []string{"solve"},
+				stdin: `{
+					"leading_zero_bit_count": 5,
+					"created_at": null,
+					"ttl": null,
+					"resource": null,
+					"serialized_payload": "dummy",
+					"hash_name": "SHA-256",
+					"hash_data_layout": "{{.Challenge.LeadingZeroBitCount.ToInt}}` +
+					`:{{.Challenge.SerializedPayload.ToString}}:{{.Nonce.ToString}}",
+					"hash_data_layout_kind": "text",
+					"nonce_encoding": null,
+					"signature": null
+				}`,
+			},
+			wantStdout: `{
+				"challenge": {
+					"leading_zero_bit_count": 5,
+					"target": null,
+					"created_at": null,
+					"ttl": null,
+					"resource": null,
+					"serialized_payload": "dummy",
+					"hash_name": "SHA-256",
+					"hash_data_layout": "{{.Challenge.LeadingZeroBitCount.ToInt}}` +
+				`:{{.Challenge.SerializedPayload.ToString}}:{{.Nonce.ToString}}",
+					"hash_data_layout_kind": "text",
+					"nonce_encoding": null,
+					"signature": null,
+					"required_hash_data_fields": null
+				},
+				"nonce": "37",
+				"hash_sum": "005d372c56e6c6b52ad4a8325654692e` +
+				`c9aa3af5f73021748bc3fdb124ae9b20"
+			}`,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/verify",
+			args: args{
+				args: []string{"verify"},
+				stdin: `{
+					"challenge": {
+						"leading_zero_bit_count": 5,
+						"created_at": null,
+						"ttl": null,
+						"resource": null,
+						"serialized_payload": "dummy",
+						"hash_name": "SHA-256",
+						"hash_data_layout": "{{.Challenge.LeadingZeroBitCount.ToInt}}` +
+					`:{{.Challenge.SerializedPayload.ToString}}:{{.Nonce.ToString}}",
+						"hash_data_layout_kind": "text",
+						"nonce_encoding": null,
+						"signature": null
+					},
+					"nonce": "37",
+					"hash_sum": null
+				}`,
+			},
+			wantStdout: `{"is_valid": true, "error": null}`,
+			wantErr:    assert.NoError,
+		},
+		{
+			name: "error/verify",
+			args: args{
+				args: []string{"verify"},
+				stdin: `{
+					"challenge": {
+						"leading_zero_bit_count": 5,
+						"created_at": null,
+						"ttl": null,
+						"resource": null,
+						"serialized_payload": "dummy",
+						"hash_name": "SHA-256",
+						"hash_data_layout": "{{.Challenge.LeadingZeroBitCount.ToInt}}` +
+					`:{{.Challenge.SerializedPayload.ToString}}:{{.Nonce.ToString}}",
+						"hash_data_layout_kind": "text",
+						"nonce_encoding": null,
+						"signature": null
+					},
+					"nonce": "38",
+					"hash_sum": null
+				}`,
+			},
+			wantStdout: `{
+				"is_valid": false,
+				"error": "hash sum doesn't fit the target\nvalidation failure"
+			}`,
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/issue with invalid flags",
+			args: args{
+				args: []string{"issue", "-leading-zero-bit-count", "1000"},
+			},
+			wantStdout: "",
+			wantErr:    assert.Error,
+		},
+		{
+			name: "error/issue with an unknown layout kind",
+			args: args{
+				args: []string{
+					"issue",
+					"-leading-zero-bit-count", "5",
+					"-layout-kind", "dummy",
+				},
+			},
+			wantStdout: "",
+			wantErr:    assert.Error,
+		},
+		{
+			name: "error/issue with an unknown layout name",
+			args: args{
+				args: []string{
+					"issue",
+					"-leading-zero-bit-count", "5",
+					"-layout-name", "dummy",
+				},
+			},
+			wantStdout: "",
+			wantErr:    assert.Error,
+		},
+		{
+			name: "error/issue with an invalid nonce encoding",
+			args: args{
+				args: []string{
+					"issue",
+					"-leading-zero-bit-count", "5",
+					"-nonce-encoding", "bytes",
+				},
+			},
+			wantStdout: "",
+			wantErr:    assert.Error,
+		},
+		{
+			name: "error/issue with a target and a difficulty",
+			args: args{
+				args: []string{"issue", "-target", "600", "-difficulty", "48"},
+			},
+			wantStdout: "",
+			wantErr:    assert.Error,
+		},
+		{
+			name: "error/solve with interruption",
+			args: args{
+				args: []string{"solve", "-max-attempts", "10"},
+				stdin: `{
+					"leading_zero_bit_count": 100,
+					"created_at": null,
+					"ttl": null,
+					"resource": null,
+					"serialized_payload": "dummy",
+					"hash_name": "SHA-256",
+					"hash_data_layout": "{{.Nonce.ToString}}",
+					"hash_data_layout_kind": "text",
+					"nonce_encoding": null,
+					"signature": null
+				}`,
+			},
+			wantStdout: "",
+			wantErr:    assert.Error,
+		},
+		{
+			name: "error/unknown command",
+			args: args{
+				args: []string{"unknown"},
+			},
+			wantStdout: "",
+			wantErr:    assert.Error,
+		},
+		{
+			name: "error/no command",
+			args: args{
+				args: nil,
+			},
+			wantStdout: "",
+			wantErr:    assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			var stdout bytes.Buffer
+			err := run(data.args.args, strings.NewReader(data.args.stdin), &stdout)
+
+			if data.wantStdout != "" {
+				assert.JSONEq(test, data.wantStdout, stdout.String())
+			} else {
+				assert.Empty(test, stdout.String())
+			}
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestRun_bench(test *testing.T) {
+	var stdout bytes.Buffer
+	err := run(
+		[]string{"bench", "-attempts", "100", "-concurrency", "2"},
+		strings.NewReader(""),
+		&stdout,
+	)
+	require.NoError(test, err)
+
+	var result benchmarkResult
+	err = json.Unmarshal(stdout.Bytes(), &result)
+	require.NoError(test, err)
+
+	assert.Equal(test, "SHA-256", result.HashName)
+	assert.Equal(test, 100, result.AttemptCount)
+	assert.Positive(test, result.HashRate)
+}
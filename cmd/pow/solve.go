@@ -0,0 +1,117 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"io"
+	"math/big"
+	"time"
+
+	"github.com/samber/mo"
+	pow "github.com/thewizardplusplus/go-pow"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+func runSolveCommand(args []string, stdin io.Reader, stdout io.Writer) error {
+	var (
+		maxAttemptCount   int
+		timeout           time.Duration
+		randomNonce       bool
+		minNonce          string
+		maxNonce          string
+		concurrencyFactor int
+	)
+	flagSet := newFlagSet("solve")
+	flagSet.IntVar(
+		&maxAttemptCount,
+		"max-attempts",
+		0,
+		"maximal number of attempts (unlimited by default)",
+	)
+	flagSet.DurationVar(
+		&timeout,
+		"timeout",
+		0,
+		"maximal duration of solving (unlimited by default)",
+	)
+	flagSet.BoolVar(
+		&randomNonce,
+		"random-nonce",
+		false,
+		"start from a random nonce instead of zero",
+	)
+	flagSet.StringVar(
+		&minNonce,
+		"min-nonce",
+		"0",
+		"minimal random nonce (inclusive)",
+	)
+	flagSet.StringVar(
+		&maxNonce,
+		"max-nonce",
+		"18446744073709551616", // 2^64
+		"maximal random nonce (exclusive)",
+	)
+	flagSet.IntVar(
+		&concurrencyFactor,
+		"concurrency",
+		1,
+		"number of goroutines used for solving",
+	)
+	if err := flagSet.Parse(args); err != nil {
+		return fmt.Errorf("unable to parse the flags: %w", err)
+	}
+
+	params := pow.SolveParams{
+		ConcurrencyFactor: mo.Some(concurrencyFactor),
+	}
+	if maxAttemptCount > 0 {
+		params.MaxAttemptCount = mo.Some(maxAttemptCount)
+	}
+	if randomNonce {
+		minRawValue, err := parseBigInt(minNonce)
+		if err != nil {
+			return fmt.Errorf("unable to parse the minimal nonce: %w", err)
+		}
+
+		maxRawValue, err := parseBigInt(maxNonce)
+		if err != nil {
+			return fmt.Errorf("unable to parse the maximal nonce: %w", err)
+		}
+
+		params.RandomInitialNonceParams = mo.Some(powValueTypes.RandomNonceParams{
+			RandomReader: rand.Reader,
+			MinRawValue:  minRawValue,
+			MaxRawValue:  maxRawValue,
+		})
+	}
+
+	var challenge pow.Challenge
+	if err := readJSON(stdin, &challenge); err != nil {
+		return fmt.Errorf("unable to read the challenge: %w", err)
+	}
+
+	ctx := context.Background()
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	solution, err := challenge.Solve(ctx, params)
+	if err != nil {
+		return fmt.Errorf("unable to solve the challenge: %w", err)
+	}
+
+	return writeJSON(stdout, solution)
+}
+
+func parseBigInt(rawValue string) (*big.Int, error) {
+	value, isParsed := big.NewInt(0).SetString(rawValue, 10)
+	if !isParsed {
+		return nil, fmt.Errorf("%q isn't an integer", rawValue)
+	}
+
+	return value, nil
+}
@@ -0,0 +1,73 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/samber/mo"
+	pow "github.com/thewizardplusplus/go-pow"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+var errVerificationFailed = errors.New("solution is invalid")
+
+type verdict struct {
+	IsValid bool              `json:"is_valid"`
+	Error   mo.Option[string] `json:"error"`
+}
+
+func runVerifyCommand(args []string, stdin io.Reader, stdout io.Writer) error {
+	var (
+		clockSkewTolerance time.Duration
+		expectedResource   string
+	)
+	flagSet := newFlagSet("verify")
+	flagSet.DurationVar(
+		&clockSkewTolerance,
+		"clock-skew-tolerance",
+		0,
+		"tolerance for a `CreatedAt` timestamp in the future",
+	)
+	flagSet.StringVar(
+		&expectedResource,
+		"resource",
+		"",
+		"expected resource URI (isn't checked by default)",
+	)
+	if err := flagSet.Parse(args); err != nil {
+		return fmt.Errorf("unable to parse the flags: %w", err)
+	}
+
+	params := pow.VerifyParams{
+		ClockSkewTolerance: mo.Some(clockSkewTolerance),
+	}
+	if expectedResource != "" {
+		resource, err := powValueTypes.ParseResource(expectedResource)
+		if err != nil {
+			return fmt.Errorf("unable to parse the expected resource: %w", err)
+		}
+
+		params.ExpectedResource = mo.Some(resource)
+	}
+
+	var solution pow.Solution
+	if err := readJSON(stdin, &solution); err != nil {
+		return fmt.Errorf("unable to read the solution: %w", err)
+	}
+
+	result := verdict{IsValid: true}
+	if err := solution.VerifyWithParams(params); err != nil {
+		result = verdict{IsValid: false, Error: mo.Some(err.Error())}
+	}
+	if err := writeJSON(stdout, result); err != nil {
+		return err
+	}
+
+	if !result.IsValid {
+		return errVerificationFailed
+	}
+
+	return nil
+}
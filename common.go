@@ -3,19 +3,29 @@ package pow
 import (
 	"math/big"
 
+	"github.com/samber/mo"
 	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
 )
 
-func makeTarget(targetBitIndex powValueTypes.TargetBitIndex) *big.Int {
-	target := big.NewInt(0)
-	target.SetBit(target, targetBitIndex.ToInt(), 1)
+func isHashSumFitTarget(
+	hashSum powValueTypes.HashSum,
+	target powValueTypes.Target,
+) bool {
+	hashSumAsBigInt := big.NewInt(0)
+	hashSumAsBigInt.SetBytes(hashSum.ToBytes())
 
-	return target
+	// hashSumAsBigInt < target
+	return hashSumAsBigInt.Cmp(target.ToBigInt()) == -1
 }
 
-func isHashSumFitTarget(hashSum powValueTypes.HashSum, target *big.Int) bool {
-	hashSumAsBigInt := big.NewInt(0)
-	hashSumAsBigInt.SetBytes(hashSum.ToBytes())
+func mapOption[T any, R any](
+	option mo.Option[T],
+	mapper func(T) R,
+) mo.Option[R] {
+	value, isPresent := option.Get()
+	if !isPresent {
+		return mo.None[R]()
+	}
 
-	return hashSumAsBigInt.Cmp(target) == -1 // hashSumAsBigInt < target
+	return mo.Some(mapper(value))
 }
@@ -5,7 +5,12 @@ import (
 )
 
 var (
-	ErrIO                = errors.New("I/O error")
-	ErrTaskInterruption  = errors.New("task interruption")
-	ErrValidationFailure = errors.New("validation failure")
+	ErrIO                  = errors.New("I/O error")
+	ErrTaskInterruption    = errors.New("task interruption")
+	ErrValidationFailure   = errors.New("validation failure")
+	ErrChallengeExpired    = errors.New("challenge expired")
+	ErrResourceMismatch    = errors.New("resource mismatch")
+	ErrInvalidSignature    = errors.New("invalid signature")
+	ErrReplayedSolution    = errors.New("replayed solution")
+	ErrNonceRangeExhausted = errors.New("nonce range exhausted")
 )
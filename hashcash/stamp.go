@@ -0,0 +1,269 @@
+package powHashcash
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/samber/mo"
+	pow "github.com/thewizardplusplus/go-pow"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+const (
+	StampVersion    = "1"
+	StampHashName   = "SHA-1"
+	DefaultStampTTL = 28 * 24 * time.Hour
+)
+
+const (
+	stampFieldSeparator = ":"
+	stampFieldCount     = 7
+	stampDateFormat     = "060102150405"
+)
+
+var (
+	// the Hashcash date may be specified with a precision of days, minutes
+	// or seconds
+	stampDateParsingFormats = map[int]string{
+		len("060102"):       "060102",
+		len("0601021504"):   "0601021504",
+		len("060102150405"): "060102150405",
+	}
+
+	// the prefix of the stamp is stored as the serialized payload,
+	// so the original representation of its fields is hashed as is
+	stampHashDataLayout = powValueTypes.MustParseHashDataLayout(
+		"{{ .Challenge.SerializedPayload.ToString }}{{ .Nonce.ToString }}",
+	)
+)
+
+type StampParams struct {
+	LeadingZeroBitCount powValueTypes.LeadingZeroBitCount
+	CreatedAt           time.Time
+	TTL                 mo.Option[time.Duration]
+	Resource            string
+	Extension           string
+	Salt                string
+}
+
+func NewChallenge(params StampParams) (pow.Challenge, error) {
+	var errs []error
+	for _, field := range []struct {
+		name  string
+		value string
+	}{
+		{name: "resource", value: params.Resource},
+		{name: "extension", value: params.Extension},
+		{name: "salt", value: params.Salt},
+	} {
+		if strings.Contains(field.value, stampFieldSeparator) {
+			errs = append(
+				errs,
+				fmt.Errorf("%s cannot contain the field separator", field.name),
+			)
+		}
+	}
+	if params.Salt == "" {
+		errs = append(errs, errors.New("salt is required"))
+	}
+	if len(errs) > 0 {
+		return pow.Challenge{}, errors.Join(errs...)
+	}
+
+	createdAt := params.CreatedAt.UTC().Truncate(time.Second)
+	return makeChallenge(stampFields{
+		leadingZeroBitCount: params.LeadingZeroBitCount,
+		rawCreatedAt:        createdAt.Format(stampDateFormat),
+		createdAt:           createdAt,
+		resource:            params.Resource,
+		extension:           params.Extension,
+		salt:                params.Salt,
+	}, params.TTL.OrElse(DefaultStampTTL))
+}
+
+func EncodeStamp(solution pow.Solution) (string, error) {
+	challenge := solution.Challenge()
+	if challenge.Hash().Name() != StampHashName {
+		return "", fmt.Errorf("hash of the challenge isn't %s", StampHashName)
+	}
+	if challenge.HashDataLayout().ToString() !=
+		stampHashDataLayout.ToString() {
+		return "", errors.New("hash data layout of the challenge isn't a stamp")
+	}
+	if challenge.ArbitraryTarget().IsPresent() {
+		return "", errors.New("stamp cannot express an arbitrary target")
+	}
+
+	stampPrefix := challenge.SerializedPayload().ToString()
+	if strings.Count(stampPrefix, stampFieldSeparator) != stampFieldCount-1 ||
+		!strings.HasPrefix(stampPrefix, StampVersion+stampFieldSeparator) ||
+		!strings.HasSuffix(stampPrefix, stampFieldSeparator) {
+		return "", errors.New("serialized payload isn't a stamp prefix")
+	}
+
+	counter := challenge.NonceEncoding().
+		OrElse(powValueTypes.DefaultNonceEncoding).
+		Encode(solution.Nonce())
+	return stampPrefix + counter, nil
+}
+
+func ParseStamp(stamp string, ttl time.Duration) (pow.Solution, error) {
+	fields := strings.Split(stamp, stampFieldSeparator)
+	if len(fields) != stampFieldCount {
+		return pow.Solution{}, fmt.Errorf(
+			"stamp should consist of %d fields",
+			stampFieldCount,
+		)
+	}
+
+	var errs []error
+	if fields[0] != StampVersion {
+		errs = append(errs, fmt.Errorf("stamp version %q is unsupported", fields[0]))
+	}
+
+	rawLeadingZeroBitCount, err := strconv.Atoi(fields[1])
+	if err != nil {
+		errs = append(errs, fmt.Errorf("unable to parse the bit count: %w", err))
+	}
+
+	leadingZeroBitCount, err :=
+		powValueTypes.NewLeadingZeroBitCount(rawLeadingZeroBitCount)
+	if err != nil {
+		errs = append(
+			errs,
+			fmt.Errorf("unable to construct the leading zero bit count: %w", err),
+		)
+	}
+
+	createdAt, err := parseStampDate(fields[2])
+	if err != nil {
+		errs = append(errs, fmt.Errorf("unable to parse the date: %w", err))
+	}
+
+	// the counter is an opaque string, so its bytes are used as the nonce,
+	// and the fixed-width encoding renders them back verbatim
+	counterEncoding, err :=
+		powValueTypes.NewFixedWidthNonceEncoding(len(fields[6]))
+	if err != nil {
+		errs = append(
+			errs,
+			fmt.Errorf("unable to construct the counter encoding: %w", err),
+		)
+	}
+
+	nonce, err := counterEncoding.Decode(fields[6])
+	if err != nil {
+		errs = append(errs, fmt.Errorf("unable to decode the counter: %w", err))
+	}
+
+	if len(errs) > 0 {
+		return pow.Solution{}, errors.Join(errs...)
+	}
+
+	challenge, err := makeChallenge(stampFields{
+		leadingZeroBitCount: leadingZeroBitCount,
+		rawCreatedAt:        fields[2],
+		createdAt:           createdAt,
+		resource:            fields[3],
+		extension:           fields[4],
+		salt:                fields[5],
+		counterEncoding:     mo.Some(counterEncoding),
+	}, ttl)
+	if err != nil {
+		return pow.Solution{}, fmt.Errorf("unable to make the challenge: %w", err)
+	}
+
+	solution, err := pow.NewSolutionBuilder().
+		SetChallenge(challenge).
+		SetNonce(nonce).
+		Build()
+	if err != nil {
+		return pow.Solution{}, fmt.Errorf("unable to build the solution: %w", err)
+	}
+
+	return solution, nil
+}
+
+type stampFields struct {
+	leadingZeroBitCount powValueTypes.LeadingZeroBitCount
+	rawCreatedAt        string
+	createdAt           time.Time
+	resource            string
+	extension           string
+	salt                string
+	counterEncoding     mo.Option[powValueTypes.NonceEncoding]
+}
+
+func makeChallenge(
+	fields stampFields,
+	ttl time.Duration,
+) (pow.Challenge, error) {
+	createdAt, err := powValueTypes.NewCreatedAt(fields.createdAt)
+	if err != nil {
+		return pow.Challenge{}, fmt.Errorf(
+			"unable to construct the `CreatedAt` timestamp: %w",
+			err,
+		)
+	}
+
+	wrappedTTL, err := powValueTypes.NewTTL(ttl)
+	if err != nil {
+		return pow.Challenge{}, fmt.Errorf("unable to construct the TTL: %w", err)
+	}
+
+	resource, err := powValueTypes.ParseResource(fields.resource)
+	if err != nil {
+		return pow.Challenge{}, fmt.Errorf("unable to parse the resource: %w", err)
+	}
+
+	hash, err := powValueTypes.DefaultHashRegistry.Lookup(StampHashName)
+	if err != nil {
+		return pow.Challenge{}, fmt.Errorf("unable to look up the hash: %w", err)
+	}
+
+	stampPrefix := strings.Join([]string{
+		StampVersion,
+		strconv.Itoa(fields.leadingZeroBitCount.ToInt()),
+		fields.rawCreatedAt,
+		fields.resource,
+		fields.extension,
+		fields.salt,
+		"", // the counter is appended by the hash data layout
+	}, stampFieldSeparator)
+
+	builder := pow.NewChallengeBuilder().
+		SetLeadingZeroBitCount(fields.leadingZeroBitCount).
+		SetCreatedAt(createdAt).
+		SetTTL(wrappedTTL).
+		SetResource(resource).
+		SetSerializedPayload(powValueTypes.NewSerializedPayload(stampPrefix)).
+		SetHash(hash).
+		SetHashDataLayout(stampHashDataLayout)
+	if counterEncoding, isPresent := fields.counterEncoding.Get(); isPresent {
+		builder.SetNonceEncoding(counterEncoding)
+	}
+
+	challenge, err := builder.Build()
+	if err != nil {
+		return pow.Challenge{}, fmt.Errorf("unable to build the challenge: %w", err)
+	}
+
+	return challenge, nil
+}
+
+func parseStampDate(rawDate string) (time.Time, error) {
+	format, isSupported := stampDateParsingFormats[len(rawDate)]
+	if !isSupported {
+		return time.Time{}, fmt.Errorf("date %q has unsupported length", rawDate)
+	}
+
+	date, err := time.ParseInLocation(format, rawDate, time.UTC)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unable to parse the time: %w", err)
+	}
+
+	return date, nil
+}
@@ -0,0 +1,500 @@
+package powHashcash
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+	"time"
+
+	"github.com/samber/mo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pow "github.com/thewizardplusplus/go-pow"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+const (
+	testStamp = "1:10:000102030405:alice@example.com::dummy:1400"
+)
+
+var (
+	testMoment = time.Date(2000, time.January, 2, 3, 4, 5, 0, time.UTC)
+)
+
+func TestNewChallenge(test *testing.T) {
+	type args struct {
+		params StampParams
+	}
+
+	for _, data := range []struct {
+		name          string
+		args          args
+		wantPayload   string
+		wantCreatedAt time.Time
+		wantTTL       time.Duration
+		wantResource  string
+		wantErr       assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/with the default TTL",
+			args: args{
+				params: StampParams{
+					LeadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(10)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					CreatedAt: testMoment.Add(6 * time.Nanosecond),
+					TTL:       mo.None[time.Duration](),
+					Resource:  "alice@example.com",
+					Extension: "",
+					Salt:      "dummy",
+				},
+			},
+			wantPayload:   "1:10:000102030405:alice@example.com::dummy:",
+			wantCreatedAt: testMoment,
+			wantTTL:       DefaultStampTTL,
+			wantResource:  "alice@example.com",
+			wantErr:       assert.NoError,
+		},
+		{
+			name: "success/with the custom TTL",
+			args: args{
+				params: StampParams{
+					LeadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(10)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					CreatedAt: testMoment.In(time.FixedZone("UTC+3", 3*60*60)),
+					TTL:       mo.Some(time.Hour),
+					Resource:  "alice@example.com",
+					Extension: "key=value",
+					Salt:      "dummy",
+				},
+			},
+			wantPayload:   "1:10:000102030405:alice@example.com:key=value:dummy:",
+			wantCreatedAt: testMoment,
+			wantTTL:       time.Hour,
+			wantResource:  "alice@example.com",
+			wantErr:       assert.NoError,
+		},
+		{
+			name: "error/field contains the separator",
+			args: args{
+				params: StampParams{
+					LeadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(10)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					CreatedAt: testMoment,
+					Resource:  "alice@example.com",
+					Extension: "key:value",
+					Salt:      "dummy",
+				},
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/salt is required",
+			args: args{
+				params: StampParams{
+					LeadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(10)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					CreatedAt: testMoment,
+					Resource:  "alice@example.com",
+					Salt:      "",
+				},
+			},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err := NewChallenge(data.args.params)
+
+			data.wantErr(test, err)
+			if err == nil {
+				assert.Equal(test, data.wantPayload, got.SerializedPayload().ToString())
+				assert.Equal(
+					test,
+					data.wantCreatedAt,
+					got.CreatedAt().MustGet().ToTime(),
+				)
+				assert.Equal(test, data.wantTTL, got.TTL().MustGet().ToDuration())
+				assert.Equal(test, data.wantResource, got.Resource().MustGet().ToString())
+				assert.Equal(test, StampHashName, got.Hash().Name())
+			}
+		})
+	}
+}
+
+func TestEncodeStamp(test *testing.T) {
+	type args struct {
+		solution pow.Solution
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		want    string
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/solved challenge",
+			args: args{
+				solution: func() pow.Solution {
+					challenge, err := NewChallenge(StampParams{
+						LeadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+							value, err := powValueTypes.NewLeadingZeroBitCount(10)
+							require.NoError(test, err)
+
+							return value
+						}(),
+						CreatedAt: testMoment,
+						Resource:  "alice@example.com",
+						Salt:      "dummy",
+					})
+					require.NoError(test, err)
+
+					solution, err :=
+						challenge.Solve(context.Background(), pow.SolveParams{})
+					require.NoError(test, err)
+
+					return solution
+				}(),
+			},
+			want:    testStamp,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/parsed stamp",
+			args: args{
+				solution: func() pow.Solution {
+					solution, err := ParseStamp(
+						"1:20:000102:alice@example.com:key=value:dummy:23",
+						DefaultStampTTL,
+					)
+					require.NoError(test, err)
+
+					return solution
+				}(),
+			},
+			want:    "1:20:000102:alice@example.com:key=value:dummy:23",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/parsed stamp with a counter in base-64",
+			args: args{
+				solution: func() pow.Solution {
+					solution, err := ParseStamp(
+						"1:20:1303030600:anni@cypherspace.org::McMybZIhxKXu57jd:ckvi",
+						DefaultStampTTL,
+					)
+					require.NoError(test, err)
+
+					return solution
+				}(),
+			},
+			want:    "1:20:1303030600:anni@cypherspace.org::McMybZIhxKXu57jd:ckvi",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/hash isn't SHA-1",
+			args: args{
+				solution: func() pow.Solution {
+					leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(10)
+					require.NoError(test, err)
+
+					challenge, err := pow.NewChallengeBuilder().
+						SetLeadingZeroBitCount(leadingZeroBitCount).
+						SetSerializedPayload(powValueTypes.NewSerializedPayload(
+							"1:10:000102030405:alice@example.com::dummy:",
+						)).
+						SetHash(powValueTypes.NewHash(sha256.New())).
+						SetHashDataLayout(stampHashDataLayout).
+						Build()
+					require.NoError(test, err)
+
+					nonce, err := powValueTypes.ParseNonce("1400")
+					require.NoError(test, err)
+
+					solution, err := pow.NewSolutionBuilder().
+						SetChallenge(challenge).
+						SetNonce(nonce).
+						Build()
+					require.NoError(test, err)
+
+					return solution
+				}(),
+			},
+			want:    "",
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/hash data layout isn't a stamp",
+			args: args{
+				solution: func() pow.Solution {
+					leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(10)
+					require.NoError(test, err)
+
+					hash, err := powValueTypes.DefaultHashRegistry.Lookup(StampHashName)
+					require.NoError(test, err)
+
+					challenge, err := pow.NewChallengeBuilder().
+						SetLeadingZeroBitCount(leadingZeroBitCount).
+						SetSerializedPayload(powValueTypes.NewSerializedPayload(
+							"1:10:000102030405:alice@example.com::dummy:",
+						)).
+						SetHash(hash).
+						SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+							"{{ .Nonce.ToString }}",
+						)).
+						Build()
+					require.NoError(test, err)
+
+					nonce, err := powValueTypes.ParseNonce("1400")
+					require.NoError(test, err)
+
+					solution, err := pow.NewSolutionBuilder().
+						SetChallenge(challenge).
+						SetNonce(nonce).
+						Build()
+					require.NoError(test, err)
+
+					return solution
+				}(),
+			},
+			want:    "",
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/serialized payload isn't a stamp prefix",
+			args: args{
+				solution: func() pow.Solution {
+					leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(10)
+					require.NoError(test, err)
+
+					hash, err := powValueTypes.DefaultHashRegistry.Lookup(StampHashName)
+					require.NoError(test, err)
+
+					challenge, err := pow.NewChallengeBuilder().
+						SetLeadingZeroBitCount(leadingZeroBitCount).
+						SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+						SetHash(hash).
+						SetHashDataLayout(stampHashDataLayout).
+						Build()
+					require.NoError(test, err)
+
+					nonce, err := powValueTypes.ParseNonce("1400")
+					require.NoError(test, err)
+
+					solution, err := pow.NewSolutionBuilder().
+						SetChallenge(challenge).
+						SetNonce(nonce).
+						Build()
+					require.NoError(test, err)
+
+					return solution
+				}(),
+			},
+			want:    "",
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err := EncodeStamp(data.args.solution)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestParseStamp(test *testing.T) {
+	type args struct {
+		stamp string
+		ttl   time.Duration
+	}
+
+	for _, data := range []struct {
+		name          string
+		args          args
+		wantBitCount  int
+		wantCreatedAt time.Time
+		wantResource  string
+		wantCounter   string
+		wantErr       assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/date with seconds",
+			args: args{
+				stamp: testStamp,
+				ttl:   DefaultStampTTL,
+			},
+			wantBitCount:  10,
+			wantCreatedAt: testMoment,
+			wantResource:  "alice@example.com",
+			wantCounter:   "1400",
+			wantErr:       assert.NoError,
+		},
+		{
+			name: "success/date with minutes",
+			args: args{
+				stamp: "1:20:0001020304:alice@example.com::dummy:23",
+				ttl:   DefaultStampTTL,
+			},
+			wantBitCount:  20,
+			wantCreatedAt: testMoment.Truncate(time.Minute),
+			wantResource:  "alice@example.com",
+			wantCounter:   "23",
+			wantErr:       assert.NoError,
+		},
+		{
+			name: "success/date with days",
+			args: args{
+				stamp: "1:20:000102:alice@example.com::dummy:23",
+				ttl:   DefaultStampTTL,
+			},
+			wantBitCount:  20,
+			wantCreatedAt: time.Date(2000, time.January, 2, 0, 0, 0, 0, time.UTC),
+			wantResource:  "alice@example.com",
+			wantCounter:   "23",
+			wantErr:       assert.NoError,
+		},
+		{
+			name: "success/counter in base-64",
+			args: args{
+				stamp: "1:20:1303030600:anni@cypherspace.org::" +
+					"McMybZIhxKXu57jd:ckvi",
+				ttl: DefaultStampTTL,
+			},
+			wantBitCount: 20,
+			wantCreatedAt: time.Date(
+				2013,
+				time.March,
+				3,
+				6,
+				0,
+				0,
+				0,
+				time.UTC,
+			),
+			wantResource: "anni@cypherspace.org",
+			wantCounter:  "ckvi",
+			wantErr:      assert.NoError,
+		},
+		{
+			name: "success/counter with leading zeros",
+			args: args{
+				stamp: "1:20:000102:alice@example.com::dummy:0023",
+				ttl:   DefaultStampTTL,
+			},
+			wantBitCount:  20,
+			wantCreatedAt: time.Date(2000, time.January, 2, 0, 0, 0, 0, time.UTC),
+			wantResource:  "alice@example.com",
+			wantCounter:   "0023",
+			wantErr:       assert.NoError,
+		},
+		{
+			name: "error/invalid field count",
+			args: args{
+				stamp: "1:20:000102:alice@example.com:dummy:23",
+				ttl:   DefaultStampTTL,
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/unsupported version",
+			args: args{
+				stamp: "0:20:000102:alice@example.com::dummy:23",
+				ttl:   DefaultStampTTL,
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/invalid bit count",
+			args: args{
+				stamp: "1:invalid:000102:alice@example.com::dummy:23",
+				ttl:   DefaultStampTTL,
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/too big bit count",
+			args: args{
+				stamp: "1:200:000102:alice@example.com::dummy:23",
+				ttl:   DefaultStampTTL,
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/invalid date length",
+			args: args{
+				stamp: "1:20:0001:alice@example.com::dummy:23",
+				ttl:   DefaultStampTTL,
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/invalid date",
+			args: args{
+				stamp: "1:20:001302:alice@example.com::dummy:23",
+				ttl:   DefaultStampTTL,
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/empty counter",
+			args: args{
+				stamp: "1:20:000102:alice@example.com::dummy:",
+				ttl:   DefaultStampTTL,
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/invalid TTL",
+			args: args{
+				stamp: testStamp,
+				ttl:   -time.Hour,
+			},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err := ParseStamp(data.args.stamp, data.args.ttl)
+
+			data.wantErr(test, err)
+			if err == nil {
+				challenge := got.Challenge()
+				assert.Equal(
+					test,
+					data.wantBitCount,
+					challenge.LeadingZeroBitCount().ToInt(),
+				)
+				assert.Equal(
+					test,
+					data.wantCreatedAt,
+					challenge.CreatedAt().MustGet().ToTime(),
+				)
+				assert.Equal(
+					test,
+					data.wantResource,
+					challenge.Resource().MustGet().ToString(),
+				)
+				assert.Equal(
+					test,
+					data.wantCounter,
+					challenge.NonceEncoding().MustGet().Encode(got.Nonce()),
+				)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+package powHashcash
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/samber/mo"
+	pow "github.com/thewizardplusplus/go-pow"
+	powErrors "github.com/thewizardplusplus/go-pow/errors"
+	powReplayGuard "github.com/thewizardplusplus/go-pow/replay-guard"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+type VerifyStampParams struct {
+	TTL                    mo.Option[time.Duration]
+	MinLeadingZeroBitCount int
+	ExpectedResource       mo.Option[string]
+	Clock                  mo.Option[pow.Clock]
+	ClockSkewTolerance     mo.Option[time.Duration]
+	ReplayGuard            mo.Option[powReplayGuard.Guard]
+}
+
+func VerifyStamp(
+	ctx context.Context,
+	stamp string,
+	params VerifyStampParams,
+) (pow.Solution, error) {
+	solution, err := ParseStamp(stamp, params.TTL.OrElse(DefaultStampTTL))
+	if err != nil {
+		return pow.Solution{}, errors.Join(
+			fmt.Errorf("unable to parse the stamp: %w", err),
+			powErrors.ErrValidationFailure,
+		)
+	}
+
+	// the bit count is chosen by the stamp minter,
+	// so it should be checked against the required one
+	leadingZeroBitCount := solution.Challenge().LeadingZeroBitCount().ToInt()
+	if leadingZeroBitCount < params.MinLeadingZeroBitCount {
+		return pow.Solution{}, errors.Join(
+			fmt.Errorf(
+				"stamp bit count %d is less than the required %d",
+				leadingZeroBitCount,
+				params.MinLeadingZeroBitCount,
+			),
+			powErrors.ErrValidationFailure,
+		)
+	}
+
+	verifyParams := pow.VerifyParams{
+		Clock:              params.Clock,
+		ClockSkewTolerance: params.ClockSkewTolerance,
+	}
+	if rawResource, isPresent := params.ExpectedResource.Get(); isPresent {
+		resource, err := powValueTypes.ParseResource(rawResource)
+		if err != nil {
+			return pow.Solution{}, fmt.Errorf(
+				"unable to parse the expected resource: %w",
+				err,
+			)
+		}
+
+		verifyParams.ExpectedResource = mo.Some(resource)
+	}
+
+	if err := solution.VerifyWithParams(verifyParams); err != nil {
+		return pow.Solution{}, fmt.Errorf("unable to verify the solution: %w", err)
+	}
+
+	// the Hashcash specification requires to reject double-spent stamps,
+	// so the stamps should be recorded until their expiration
+	if replayGuard, isPresent := params.ReplayGuard.Get(); isPresent {
+		if err := replayGuard.Accept(ctx, solution); err != nil {
+			return pow.Solution{}, fmt.Errorf(
+				"unable to accept the solution: %w",
+				err,
+			)
+		}
+	}
+
+	return solution, nil
+}
@@ -0,0 +1,186 @@
+package powHashcash
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/samber/mo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pow "github.com/thewizardplusplus/go-pow"
+	powErrors "github.com/thewizardplusplus/go-pow/errors"
+	powReplayGuard "github.com/thewizardplusplus/go-pow/replay-guard"
+)
+
+func TestVerifyStamp(test *testing.T) {
+	type args struct {
+		stamp  string
+		params VerifyStampParams
+	}
+
+	for _, data := range []struct {
+		name           string
+		acceptedStamps []string
+		args           args
+		wantErr        assert.ErrorAssertionFunc
+	}{
+		{
+			name:           "success/required parameters only",
+			acceptedStamps: nil,
+			args: args{
+				stamp: testStamp,
+				params: VerifyStampParams{
+					MinLeadingZeroBitCount: 10,
+					Clock: mo.Some[pow.Clock](
+						pow.NewFixedClock(testMoment.Add(time.Hour)),
+					),
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:           "success/all parameters",
+			acceptedStamps: nil,
+			args: args{
+				stamp: testStamp,
+				params: VerifyStampParams{
+					TTL:                    mo.Some(2 * time.Hour),
+					MinLeadingZeroBitCount: 5,
+					ExpectedResource:       mo.Some("alice@example.com"),
+					Clock: mo.Some[pow.Clock](
+						pow.NewFixedClock(testMoment.Add(-time.Minute)),
+					),
+					ClockSkewTolerance: mo.Some(2 * time.Minute),
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:           "success/counter in base-64",
+			acceptedStamps: nil,
+			args: args{
+				stamp: "1:20:1303030600:adam@cypherspace.org::McMybZIhxKXu57jd:ckvi",
+				params: VerifyStampParams{
+					MinLeadingZeroBitCount: 20,
+					ExpectedResource:       mo.Some("adam@cypherspace.org"),
+					Clock: mo.Some[pow.Clock](pow.NewFixedClock(
+						time.Date(2013, time.March, 3, 7, 0, 0, 0, time.UTC),
+					)),
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:           "error/unable to parse the stamp",
+			acceptedStamps: nil,
+			args: args{
+				stamp: "invalid",
+				params: VerifyStampParams{
+					MinLeadingZeroBitCount: 10,
+				},
+			},
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrValidationFailure)
+			},
+		},
+		{
+			name:           "error/bit count is too small",
+			acceptedStamps: nil,
+			args: args{
+				stamp: testStamp,
+				params: VerifyStampParams{
+					MinLeadingZeroBitCount: 20,
+					Clock: mo.Some[pow.Clock](
+						pow.NewFixedClock(testMoment.Add(time.Hour)),
+					),
+				},
+			},
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrValidationFailure)
+			},
+		},
+		{
+			name:           "error/hash sum doesn't fit the target",
+			acceptedStamps: nil,
+			args: args{
+				stamp: "1:10:000102030405:alice@example.com::dummy:1401",
+				params: VerifyStampParams{
+					MinLeadingZeroBitCount: 10,
+					Clock: mo.Some[pow.Clock](
+						pow.NewFixedClock(testMoment.Add(time.Hour)),
+					),
+				},
+			},
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrValidationFailure)
+			},
+		},
+		{
+			name:           "error/stamp is expired",
+			acceptedStamps: nil,
+			args: args{
+				stamp: testStamp,
+				params: VerifyStampParams{
+					MinLeadingZeroBitCount: 10,
+					Clock: mo.Some[pow.Clock](
+						pow.NewFixedClock(testMoment.Add(DefaultStampTTL + time.Hour)),
+					),
+				},
+			},
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrChallengeExpired)
+			},
+		},
+		{
+			name:           "error/resource mismatch",
+			acceptedStamps: nil,
+			args: args{
+				stamp: testStamp,
+				params: VerifyStampParams{
+					MinLeadingZeroBitCount: 10,
+					ExpectedResource:       mo.Some("bob@example.com"),
+					Clock: mo.Some[pow.Clock](
+						pow.NewFixedClock(testMoment.Add(time.Hour)),
+					),
+				},
+			},
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrResourceMismatch)
+			},
+		},
+		{
+			name:           "error/double-spent stamp",
+			acceptedStamps: []string{testStamp},
+			args: args{
+				stamp: testStamp,
+				params: VerifyStampParams{
+					MinLeadingZeroBitCount: 10,
+					Clock: mo.Some[pow.Clock](
+						pow.NewFixedClock(testMoment.Add(time.Hour)),
+					),
+				},
+			},
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrReplayedSolution)
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			params := data.args.params
+			params.ReplayGuard = mo.Some(powReplayGuard.NewGuard(
+				powReplayGuard.NewMemoryStore(powReplayGuard.MemoryStoreParams{
+					Clock: params.Clock,
+				}),
+			))
+			for _, stamp := range data.acceptedStamps {
+				_, err := VerifyStamp(context.Background(), stamp, params)
+				require.NoError(test, err)
+			}
+
+			_, err := VerifyStamp(context.Background(), data.args.stamp, params)
+
+			data.wantErr(test, err)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package powHTTP
+
+import (
+	"context"
+
+	pow "github.com/thewizardplusplus/go-pow"
+)
+
+type solutionContextKey struct{}
+
+func SolutionFromContext(ctx context.Context) (pow.Solution, bool) {
+	solution, isPresent := ctx.Value(solutionContextKey{}).(pow.Solution)
+	return solution, isPresent
+}
+
+func contextWithSolution(
+	ctx context.Context,
+	solution pow.Solution,
+) context.Context {
+	return context.WithValue(ctx, solutionContextKey{}, solution)
+}
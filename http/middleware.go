@@ -0,0 +1,254 @@
+package powHTTP
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/samber/mo"
+	pow "github.com/thewizardplusplus/go-pow"
+	powErrors "github.com/thewizardplusplus/go-pow/errors"
+	powReplayGuard "github.com/thewizardplusplus/go-pow/replay-guard"
+	powSigning "github.com/thewizardplusplus/go-pow/signing"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+type ChallengeTemplate func(
+	request *http.Request,
+) (*pow.ChallengeBuilder, error)
+
+type ResourceExtractor func(request *http.Request) powValueTypes.Resource
+
+type MiddlewareParams struct {
+	ChallengeTemplate  ChallengeTemplate
+	Signer             powSigning.Signer
+	Verifier           powSigning.Verifier
+	HashRegistry       mo.Option[*powValueTypes.HashRegistry]
+	Clock              mo.Option[pow.Clock]
+	ClockSkewTolerance mo.Option[time.Duration]
+	ExpectedResource   ResourceExtractor
+	ReplayGuard        mo.Option[powReplayGuard.Guard]
+}
+
+func (params MiddlewareParams) validate() error {
+	var errs []error
+	if params.ChallengeTemplate == nil {
+		errs = append(errs, errors.New("challenge template is required"))
+	}
+	if params.Signer.IsZero() {
+		errs = append(errs, errors.New("signer is required"))
+	}
+	if params.Verifier.IsZero() {
+		errs = append(errs, errors.New("verifier is required"))
+	}
+	// otherwise, a solution for a cheap resource would be accepted
+	// for an expensive one
+	if params.ExpectedResource == nil {
+		errs = append(errs, errors.New("expected resource is required"))
+	}
+
+	return errors.Join(errs...)
+}
+
+type Middleware struct {
+	params MiddlewareParams
+}
+
+func NewMiddleware(params MiddlewareParams) (Middleware, error) {
+	if err := params.validate(); err != nil {
+		return Middleware{}, fmt.Errorf(
+			"unable to validate the parameters: %w",
+			err,
+		)
+	}
+
+	middleware := Middleware{
+		params: params,
+	}
+	return middleware, nil
+}
+
+func (middleware Middleware) Wrap(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(
+		writer http.ResponseWriter,
+		request *http.Request,
+	) {
+		rawSolution := request.Header.Get(SolutionHeader)
+		if rawSolution == "" {
+			middleware.reject(
+				writer,
+				request,
+				http.StatusUnauthorized,
+				RejectionCodeSolutionRequired,
+				errors.New("solution is required"),
+			)
+			return
+		}
+
+		solution, err := DecodeSignedSolution(
+			rawSolution,
+			middleware.params.Verifier,
+			middleware.params.HashRegistry.
+				OrElse(powValueTypes.DefaultHashRegistry),
+		)
+		if err != nil {
+			statusCode, code := classifyDecodingError(err)
+			middleware.reject(
+				writer,
+				request,
+				statusCode,
+				code,
+				fmt.Errorf("unable to decode the solution: %w", err),
+			)
+			return
+		}
+
+		if err := middleware.verifySolution(request, solution); err != nil {
+			statusCode, code := classifyVerificationError(err)
+			if code == RejectionCodeInternalError {
+				// details of internal errors shouldn't be exposed to the client
+				err = errors.New("unable to verify the solution")
+			}
+
+			middleware.reject(writer, request, statusCode, code, err)
+			return
+		}
+
+		next.ServeHTTP(
+			writer,
+			request.WithContext(contextWithSolution(request.Context(), solution)),
+		)
+	})
+}
+
+func (middleware Middleware) verifySolution(
+	request *http.Request,
+	solution pow.Solution,
+) error {
+	if err := middleware.params.Verifier.VerifySolution(
+		solution,
+		pow.VerifyParams{
+			Clock:              middleware.params.Clock,
+			ClockSkewTolerance: middleware.params.ClockSkewTolerance,
+			ExpectedResource: mo.Some(
+				middleware.params.ExpectedResource(request),
+			),
+		},
+	); err != nil {
+		return fmt.Errorf("unable to verify the solution: %w", err)
+	}
+
+	if replayGuard, isPresent := middleware.params.ReplayGuard.Get(); isPresent {
+		if err := replayGuard.Accept(request.Context(), solution); err != nil {
+			return fmt.Errorf("unable to accept the solution: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (middleware Middleware) issueChallenge(
+	request *http.Request,
+) (pow.Challenge, error) {
+	builder, err := middleware.params.ChallengeTemplate(request)
+	if err != nil {
+		return pow.Challenge{}, fmt.Errorf(
+			"unable to make the challenge builder: %w",
+			err,
+		)
+	}
+
+	challenge, err := builder.Build()
+	if err != nil {
+		return pow.Challenge{}, fmt.Errorf("unable to build the challenge: %w", err)
+	}
+
+	signedChallenge, err := middleware.params.Signer.Sign(challenge)
+	if err != nil {
+		return pow.Challenge{}, fmt.Errorf("unable to sign the challenge: %w", err)
+	}
+
+	return signedChallenge, nil
+}
+
+func (middleware Middleware) reject(
+	writer http.ResponseWriter,
+	request *http.Request,
+	statusCode int,
+	code RejectionCode,
+	rejectionErr error,
+) {
+	rejection := Rejection{
+		Code:    code,
+		Message: rejectionErr.Error(),
+	}
+
+	// a fresh challenge is issued on each rejection,
+	// so the client is able to retry the request
+	challenge, err := middleware.issueChallenge(request)
+	if err != nil {
+		writeRejection(writer, http.StatusInternalServerError, Rejection{
+			Code:    RejectionCodeInternalError,
+			Message: "unable to issue the challenge",
+		})
+		return
+	}
+
+	encodedChallenge, err := EncodeChallenge(challenge)
+	if err != nil {
+		writeRejection(writer, http.StatusInternalServerError, Rejection{
+			Code:    RejectionCodeInternalError,
+			Message: "unable to encode the challenge",
+		})
+		return
+	}
+
+	writer.Header().Set(ChallengeHeader, encodedChallenge)
+	if statusCode == http.StatusUnauthorized {
+		writer.Header().Set("WWW-Authenticate", "PoW")
+	}
+
+	rejection.Challenge = mo.Some(challenge)
+	writeRejection(writer, statusCode, rejection)
+}
+
+// the signature is checked on decoding,
+// so the challenge of a forged solution isn't even built
+func classifyDecodingError(err error) (int, RejectionCode) {
+	switch {
+	case errors.Is(err, powErrors.ErrInvalidSignature):
+		return http.StatusForbidden, RejectionCodeInvalidSignature
+	default:
+		return http.StatusBadRequest, RejectionCodeMalformedSolution
+	}
+}
+
+func classifyVerificationError(err error) (int, RejectionCode) {
+	switch {
+	case errors.Is(err, powErrors.ErrChallengeExpired):
+		return http.StatusUnauthorized, RejectionCodeChallengeExpired
+	case errors.Is(err, powErrors.ErrInvalidSignature):
+		return http.StatusForbidden, RejectionCodeInvalidSignature
+	case errors.Is(err, powErrors.ErrResourceMismatch):
+		return http.StatusForbidden, RejectionCodeResourceMismatch
+	case errors.Is(err, powErrors.ErrReplayedSolution):
+		return http.StatusForbidden, RejectionCodeReplayedSolution
+	case errors.Is(err, powErrors.ErrValidationFailure):
+		return http.StatusForbidden, RejectionCodeInvalidSolution
+	default:
+		return http.StatusInternalServerError, RejectionCodeInternalError
+	}
+}
+
+func writeRejection(
+	writer http.ResponseWriter,
+	statusCode int,
+	rejection Rejection,
+) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(statusCode)
+
+	json.NewEncoder(writer).Encode(rejection) //nolint:errcheck
+}
@@ -0,0 +1,1161 @@
+package powHTTP
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/samber/mo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pow "github.com/thewizardplusplus/go-pow"
+	powReplayGuard "github.com/thewizardplusplus/go-pow/replay-guard"
+	powSigning "github.com/thewizardplusplus/go-pow/signing"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+var (
+	testMoment = time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC)
+	testKey    = powSigning.Key{ID: "key-1", Secret: []byte("secret-1")}
+)
+
+func TestNewMiddleware(test *testing.T) {
+	type args struct {
+		params MiddlewareParams
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				params: MiddlewareParams{
+					ChallengeTemplate: func(
+						request *http.Request,
+					) (*pow.ChallengeBuilder, error) {
+						return pow.NewChallengeBuilder(), nil
+					},
+					Signer: func() powSigning.Signer {
+						signer, err := powSigning.NewSigner(testKey)
+						require.NoError(test, err)
+
+						return signer
+					}(),
+					Verifier: func() powSigning.Verifier {
+						verifier, err := powSigning.NewVerifier(testKey)
+						require.NoError(test, err)
+
+						return verifier
+					}(),
+					ExpectedResource: func(
+						request *http.Request,
+					) powValueTypes.Resource {
+						return powValueTypes.NewResource(request.URL)
+					},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/challenge template is required",
+			args: args{
+				params: MiddlewareParams{
+					Signer: func() powSigning.Signer {
+						signer, err := powSigning.NewSigner(testKey)
+						require.NoError(test, err)
+
+						return signer
+					}(),
+					Verifier: func() powSigning.Verifier {
+						verifier, err := powSigning.NewVerifier(testKey)
+						require.NoError(test, err)
+
+						return verifier
+					}(),
+					ExpectedResource: func(
+						request *http.Request,
+					) powValueTypes.Resource {
+						return powValueTypes.NewResource(request.URL)
+					},
+				},
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/signer is required",
+			args: args{
+				params: MiddlewareParams{
+					ChallengeTemplate: func(
+						request *http.Request,
+					) (*pow.ChallengeBuilder, error) {
+						return pow.NewChallengeBuilder(), nil
+					},
+					Verifier: func() powSigning.Verifier {
+						verifier, err := powSigning.NewVerifier(testKey)
+						require.NoError(test, err)
+
+						return verifier
+					}(),
+					ExpectedResource: func(
+						request *http.Request,
+					) powValueTypes.Resource {
+						return powValueTypes.NewResource(request.URL)
+					},
+				},
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/verifier is required",
+			args: args{
+				params: MiddlewareParams{
+					ChallengeTemplate: func(
+						request *http.Request,
+					) (*pow.ChallengeBuilder, error) {
+						return pow.NewChallengeBuilder(), nil
+					},
+					Signer: func() powSigning.Signer {
+						signer, err := powSigning.NewSigner(testKey)
+						require.NoError(test, err)
+
+						return signer
+					}(),
+					ExpectedResource: func(
+						request *http.Request,
+					) powValueTypes.Resource {
+						return powValueTypes.NewResource(request.URL)
+					},
+				},
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/expected resource is required",
+			args: args{
+				params: MiddlewareParams{
+					ChallengeTemplate: func(
+						request *http.Request,
+					) (*pow.ChallengeBuilder, error) {
+						return pow.NewChallengeBuilder(), nil
+					},
+					Signer: func() powSigning.Signer {
+						signer, err := powSigning.NewSigner(testKey)
+						require.NoError(test, err)
+
+						return signer
+					}(),
+					Verifier: func() powSigning.Verifier {
+						verifier, err := powSigning.NewVerifier(testKey)
+						require.NoError(test, err)
+
+						return verifier
+					}(),
+				},
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/all parameters are missed",
+			args: args{
+				params: MiddlewareParams{},
+			},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			_, err := NewMiddleware(data.args.params)
+
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestMiddleware_Wrap(test *testing.T) {
+	for _, data := range []struct {
+		name                string
+		prepareParams       func(test *testing.T) MiddlewareParams
+		prepareRequests     func(test *testing.T) []*http.Request
+		wantStatusCode      int
+		wantRejectionCode   mo.Option[RejectionCode]
+		wantIssuedChallenge bool
+	}{
+		{
+			name: "success",
+			prepareParams: func(test *testing.T) MiddlewareParams {
+				leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+				require.NoError(test, err)
+
+				createdAt, err := powValueTypes.NewCreatedAt(testMoment.Add(time.Minute))
+				require.NoError(test, err)
+
+				ttl, err := powValueTypes.NewTTL(time.Hour)
+				require.NoError(test, err)
+
+				hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+				require.NoError(test, err)
+
+				signer, err := powSigning.NewSigner(testKey)
+				require.NoError(test, err)
+
+				verifier, err := powSigning.NewVerifier(testKey)
+				require.NoError(test, err)
+
+				clock := pow.NewFixedClock(testMoment.Add(time.Minute))
+				return MiddlewareParams{
+					ChallengeTemplate: func(
+						request *http.Request,
+					) (*pow.ChallengeBuilder, error) {
+						builder := pow.NewChallengeBuilder().
+							SetLeadingZeroBitCount(leadingZeroBitCount).
+							SetCreatedAt(createdAt).
+							SetTTL(ttl).
+							SetResource(powValueTypes.NewResource(request.URL)).
+							SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+							SetHash(hash).
+							SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+								"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+									":{{ .Challenge.SerializedPayload.ToString }}" +
+									":{{ .Nonce.ToString }}",
+							))
+						return builder, nil
+					},
+					Signer:   signer,
+					Verifier: verifier,
+					Clock:    mo.Some[pow.Clock](clock),
+					ExpectedResource: func(
+						request *http.Request,
+					) powValueTypes.Resource {
+						return powValueTypes.NewResource(request.URL)
+					},
+					ReplayGuard: mo.Some(powReplayGuard.NewGuard(
+						powReplayGuard.NewMemoryStore(powReplayGuard.MemoryStoreParams{
+							Clock: mo.Some[pow.Clock](clock),
+						}),
+					)),
+				}
+			},
+			prepareRequests: func(test *testing.T) []*http.Request {
+				leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+				require.NoError(test, err)
+
+				createdAt, err := powValueTypes.NewCreatedAt(testMoment)
+				require.NoError(test, err)
+
+				ttl, err := powValueTypes.NewTTL(time.Hour)
+				require.NoError(test, err)
+
+				resource, err := url.Parse("https://example.com/")
+				require.NoError(test, err)
+
+				hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+				require.NoError(test, err)
+
+				challenge, err := pow.NewChallengeBuilder().
+					SetLeadingZeroBitCount(leadingZeroBitCount).
+					SetCreatedAt(createdAt).
+					SetTTL(ttl).
+					SetResource(powValueTypes.NewResource(resource)).
+					SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+					SetHash(hash).
+					SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+							":{{ .Challenge.SerializedPayload.ToString }}" +
+							":{{ .Nonce.ToString }}",
+					)).
+					Build()
+				require.NoError(test, err)
+
+				signer, err := powSigning.NewSigner(testKey)
+				require.NoError(test, err)
+
+				signedChallenge, err := signer.Sign(challenge)
+				require.NoError(test, err)
+
+				solution, err := signedChallenge.Solve(
+					context.Background(),
+					pow.SolveParams{},
+				)
+				require.NoError(test, err)
+
+				encodedSolution, err := EncodeSolution(solution)
+				require.NoError(test, err)
+
+				request := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+				request.Header.Set(SolutionHeader, encodedSolution)
+
+				return []*http.Request{request}
+			},
+			wantStatusCode:      http.StatusOK,
+			wantRejectionCode:   mo.None[RejectionCode](),
+			wantIssuedChallenge: false,
+		},
+		{
+			name: "error/solution is required",
+			prepareParams: func(test *testing.T) MiddlewareParams {
+				leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+				require.NoError(test, err)
+
+				createdAt, err := powValueTypes.NewCreatedAt(testMoment)
+				require.NoError(test, err)
+
+				ttl, err := powValueTypes.NewTTL(time.Hour)
+				require.NoError(test, err)
+
+				hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+				require.NoError(test, err)
+
+				signer, err := powSigning.NewSigner(testKey)
+				require.NoError(test, err)
+
+				verifier, err := powSigning.NewVerifier(testKey)
+				require.NoError(test, err)
+
+				clock := pow.NewFixedClock(testMoment)
+				return MiddlewareParams{
+					ChallengeTemplate: func(
+						request *http.Request,
+					) (*pow.ChallengeBuilder, error) {
+						builder := pow.NewChallengeBuilder().
+							SetLeadingZeroBitCount(leadingZeroBitCount).
+							SetCreatedAt(createdAt).
+							SetTTL(ttl).
+							SetResource(powValueTypes.NewResource(request.URL)).
+							SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+							SetHash(hash).
+							SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+								"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+									":{{ .Challenge.SerializedPayload.ToString }}" +
+									":{{ .Nonce.ToString }}",
+							))
+						return builder, nil
+					},
+					Signer:   signer,
+					Verifier: verifier,
+					Clock:    mo.Some[pow.Clock](clock),
+					ExpectedResource: func(
+						request *http.Request,
+					) powValueTypes.Resource {
+						return powValueTypes.NewResource(request.URL)
+					},
+					ReplayGuard: mo.Some(powReplayGuard.NewGuard(
+						powReplayGuard.NewMemoryStore(powReplayGuard.MemoryStoreParams{
+							Clock: mo.Some[pow.Clock](clock),
+						}),
+					)),
+				}
+			},
+			prepareRequests: func(test *testing.T) []*http.Request {
+				request := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+				return []*http.Request{request}
+			},
+			wantStatusCode:      http.StatusUnauthorized,
+			wantRejectionCode:   mo.Some(RejectionCodeSolutionRequired),
+			wantIssuedChallenge: true,
+		},
+		{
+			name: "error/malformed solution",
+			prepareParams: func(test *testing.T) MiddlewareParams {
+				leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+				require.NoError(test, err)
+
+				createdAt, err := powValueTypes.NewCreatedAt(testMoment)
+				require.NoError(test, err)
+
+				ttl, err := powValueTypes.NewTTL(time.Hour)
+				require.NoError(test, err)
+
+				hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+				require.NoError(test, err)
+
+				signer, err := powSigning.NewSigner(testKey)
+				require.NoError(test, err)
+
+				verifier, err := powSigning.NewVerifier(testKey)
+				require.NoError(test, err)
+
+				clock := pow.NewFixedClock(testMoment)
+				return MiddlewareParams{
+					ChallengeTemplate: func(
+						request *http.Request,
+					) (*pow.ChallengeBuilder, error) {
+						builder := pow.NewChallengeBuilder().
+							SetLeadingZeroBitCount(leadingZeroBitCount).
+							SetCreatedAt(createdAt).
+							SetTTL(ttl).
+							SetResource(powValueTypes.NewResource(request.URL)).
+							SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+							SetHash(hash).
+							SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+								"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+									":{{ .Challenge.SerializedPayload.ToString }}" +
+									":{{ .Nonce.ToString }}",
+							))
+						return builder, nil
+					},
+					Signer:   signer,
+					Verifier: verifier,
+					Clock:    mo.Some[pow.Clock](clock),
+					ExpectedResource: func(
+						request *http.Request,
+					) powValueTypes.Resource {
+						return powValueTypes.NewResource(request.URL)
+					},
+					ReplayGuard: mo.Some(powReplayGuard.NewGuard(
+						powReplayGuard.NewMemoryStore(powReplayGuard.MemoryStoreParams{
+							Clock: mo.Some[pow.Clock](clock),
+						}),
+					)),
+				}
+			},
+			prepareRequests: func(test *testing.T) []*http.Request {
+				request := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+				request.Header.Set(SolutionHeader, "invalid")
+
+				return []*http.Request{request}
+			},
+			wantStatusCode:      http.StatusBadRequest,
+			wantRejectionCode:   mo.Some(RejectionCodeMalformedSolution),
+			wantIssuedChallenge: true,
+		},
+		{
+			name: "error/forged challenge",
+			prepareParams: func(test *testing.T) MiddlewareParams {
+				leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+				require.NoError(test, err)
+
+				createdAt, err := powValueTypes.NewCreatedAt(testMoment)
+				require.NoError(test, err)
+
+				ttl, err := powValueTypes.NewTTL(time.Hour)
+				require.NoError(test, err)
+
+				hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+				require.NoError(test, err)
+
+				signer, err := powSigning.NewSigner(testKey)
+				require.NoError(test, err)
+
+				verifier, err := powSigning.NewVerifier(testKey)
+				require.NoError(test, err)
+
+				clock := pow.NewFixedClock(testMoment)
+				return MiddlewareParams{
+					ChallengeTemplate: func(
+						request *http.Request,
+					) (*pow.ChallengeBuilder, error) {
+						builder := pow.NewChallengeBuilder().
+							SetLeadingZeroBitCount(leadingZeroBitCount).
+							SetCreatedAt(createdAt).
+							SetTTL(ttl).
+							SetResource(powValueTypes.NewResource(request.URL)).
+							SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+							SetHash(hash).
+							SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+								"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+									":{{ .Challenge.SerializedPayload.ToString }}" +
+									":{{ .Nonce.ToString }}",
+							))
+						return builder, nil
+					},
+					Signer:   signer,
+					Verifier: verifier,
+					Clock:    mo.Some[pow.Clock](clock),
+					ExpectedResource: func(
+						request *http.Request,
+					) powValueTypes.Resource {
+						return powValueTypes.NewResource(request.URL)
+					},
+					ReplayGuard: mo.Some(powReplayGuard.NewGuard(
+						powReplayGuard.NewMemoryStore(powReplayGuard.MemoryStoreParams{
+							Clock: mo.Some[pow.Clock](clock),
+						}),
+					)),
+				}
+			},
+			prepareRequests: func(test *testing.T) []*http.Request {
+				request := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+				// the layout is invalid, so the solution would be rejected
+				// as a malformed one, if the layout were parsed before
+				// the signature check
+				request.Header.Set(
+					SolutionHeader,
+					base64.RawURLEncoding.EncodeToString([]byte(`{
+						"challenge": {
+							"leading_zero_bit_count": 0,
+							"serialized_payload": "dummy",
+							"hash_name": "SHA-256",
+							"hash_data_layout": "{{ .Nonce",
+							"hash_data_layout_kind": "text"
+						},
+						"nonce": "0"
+					}`)),
+				)
+
+				return []*http.Request{request}
+			},
+			wantStatusCode:      http.StatusForbidden,
+			wantRejectionCode:   mo.Some(RejectionCodeInvalidSignature),
+			wantIssuedChallenge: true,
+		},
+		{
+			name: "error/challenge is expired",
+			prepareParams: func(test *testing.T) MiddlewareParams {
+				leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+				require.NoError(test, err)
+
+				createdAt, err := powValueTypes.NewCreatedAt(testMoment.Add(2 * time.Hour))
+				require.NoError(test, err)
+
+				ttl, err := powValueTypes.NewTTL(time.Hour)
+				require.NoError(test, err)
+
+				hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+				require.NoError(test, err)
+
+				signer, err := powSigning.NewSigner(testKey)
+				require.NoError(test, err)
+
+				verifier, err := powSigning.NewVerifier(testKey)
+				require.NoError(test, err)
+
+				clock := pow.NewFixedClock(testMoment.Add(2 * time.Hour))
+				return MiddlewareParams{
+					ChallengeTemplate: func(
+						request *http.Request,
+					) (*pow.ChallengeBuilder, error) {
+						builder := pow.NewChallengeBuilder().
+							SetLeadingZeroBitCount(leadingZeroBitCount).
+							SetCreatedAt(createdAt).
+							SetTTL(ttl).
+							SetResource(powValueTypes.NewResource(request.URL)).
+							SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+							SetHash(hash).
+							SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+								"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+									":{{ .Challenge.SerializedPayload.ToString }}" +
+									":{{ .Nonce.ToString }}",
+							))
+						return builder, nil
+					},
+					Signer:   signer,
+					Verifier: verifier,
+					Clock:    mo.Some[pow.Clock](clock),
+					ExpectedResource: func(
+						request *http.Request,
+					) powValueTypes.Resource {
+						return powValueTypes.NewResource(request.URL)
+					},
+					ReplayGuard: mo.Some(powReplayGuard.NewGuard(
+						powReplayGuard.NewMemoryStore(powReplayGuard.MemoryStoreParams{
+							Clock: mo.Some[pow.Clock](clock),
+						}),
+					)),
+				}
+			},
+			prepareRequests: func(test *testing.T) []*http.Request {
+				leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+				require.NoError(test, err)
+
+				createdAt, err := powValueTypes.NewCreatedAt(testMoment)
+				require.NoError(test, err)
+
+				ttl, err := powValueTypes.NewTTL(time.Hour)
+				require.NoError(test, err)
+
+				resource, err := url.Parse("https://example.com/")
+				require.NoError(test, err)
+
+				hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+				require.NoError(test, err)
+
+				challenge, err := pow.NewChallengeBuilder().
+					SetLeadingZeroBitCount(leadingZeroBitCount).
+					SetCreatedAt(createdAt).
+					SetTTL(ttl).
+					SetResource(powValueTypes.NewResource(resource)).
+					SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+					SetHash(hash).
+					SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+							":{{ .Challenge.SerializedPayload.ToString }}" +
+							":{{ .Nonce.ToString }}",
+					)).
+					Build()
+				require.NoError(test, err)
+
+				signer, err := powSigning.NewSigner(testKey)
+				require.NoError(test, err)
+
+				signedChallenge, err := signer.Sign(challenge)
+				require.NoError(test, err)
+
+				solution, err := signedChallenge.Solve(
+					context.Background(),
+					pow.SolveParams{},
+				)
+				require.NoError(test, err)
+
+				encodedSolution, err := EncodeSolution(solution)
+				require.NoError(test, err)
+
+				request := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+				request.Header.Set(SolutionHeader, encodedSolution)
+
+				return []*http.Request{request}
+			},
+			wantStatusCode:      http.StatusUnauthorized,
+			wantRejectionCode:   mo.Some(RejectionCodeChallengeExpired),
+			wantIssuedChallenge: true,
+		},
+		{
+			name: "error/invalid signature",
+			prepareParams: func(test *testing.T) MiddlewareParams {
+				leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+				require.NoError(test, err)
+
+				createdAt, err := powValueTypes.NewCreatedAt(testMoment)
+				require.NoError(test, err)
+
+				ttl, err := powValueTypes.NewTTL(time.Hour)
+				require.NoError(test, err)
+
+				hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+				require.NoError(test, err)
+
+				signer, err := powSigning.NewSigner(testKey)
+				require.NoError(test, err)
+
+				verifier, err := powSigning.NewVerifier(testKey)
+				require.NoError(test, err)
+
+				clock := pow.NewFixedClock(testMoment)
+				return MiddlewareParams{
+					ChallengeTemplate: func(
+						request *http.Request,
+					) (*pow.ChallengeBuilder, error) {
+						builder := pow.NewChallengeBuilder().
+							SetLeadingZeroBitCount(leadingZeroBitCount).
+							SetCreatedAt(createdAt).
+							SetTTL(ttl).
+							SetResource(powValueTypes.NewResource(request.URL)).
+							SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+							SetHash(hash).
+							SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+								"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+									":{{ .Challenge.SerializedPayload.ToString }}" +
+									":{{ .Nonce.ToString }}",
+							))
+						return builder, nil
+					},
+					Signer:   signer,
+					Verifier: verifier,
+					Clock:    mo.Some[pow.Clock](clock),
+					ExpectedResource: func(
+						request *http.Request,
+					) powValueTypes.Resource {
+						return powValueTypes.NewResource(request.URL)
+					},
+					ReplayGuard: mo.Some(powReplayGuard.NewGuard(
+						powReplayGuard.NewMemoryStore(powReplayGuard.MemoryStoreParams{
+							Clock: mo.Some[pow.Clock](clock),
+						}),
+					)),
+				}
+			},
+			prepareRequests: func(test *testing.T) []*http.Request {
+				leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+				require.NoError(test, err)
+
+				createdAt, err := powValueTypes.NewCreatedAt(testMoment)
+				require.NoError(test, err)
+
+				ttl, err := powValueTypes.NewTTL(time.Hour)
+				require.NoError(test, err)
+
+				resource, err := url.Parse("https://example.com/")
+				require.NoError(test, err)
+
+				hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+				require.NoError(test, err)
+
+				challenge, err := pow.NewChallengeBuilder().
+					SetLeadingZeroBitCount(leadingZeroBitCount).
+					SetCreatedAt(createdAt).
+					SetTTL(ttl).
+					SetResource(powValueTypes.NewResource(resource)).
+					SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+					SetHash(hash).
+					SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+							":{{ .Challenge.SerializedPayload.ToString }}" +
+							":{{ .Nonce.ToString }}",
+					)).
+					Build()
+				require.NoError(test, err)
+
+				solution, err := challenge.Solve(
+					context.Background(),
+					pow.SolveParams{},
+				)
+				require.NoError(test, err)
+
+				encodedSolution, err := EncodeSolution(solution)
+				require.NoError(test, err)
+
+				request := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+				request.Header.Set(SolutionHeader, encodedSolution)
+
+				return []*http.Request{request}
+			},
+			wantStatusCode:      http.StatusForbidden,
+			wantRejectionCode:   mo.Some(RejectionCodeInvalidSignature),
+			wantIssuedChallenge: true,
+		},
+		{
+			name: "error/resource mismatch",
+			prepareParams: func(test *testing.T) MiddlewareParams {
+				leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+				require.NoError(test, err)
+
+				createdAt, err := powValueTypes.NewCreatedAt(testMoment)
+				require.NoError(test, err)
+
+				ttl, err := powValueTypes.NewTTL(time.Hour)
+				require.NoError(test, err)
+
+				hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+				require.NoError(test, err)
+
+				signer, err := powSigning.NewSigner(testKey)
+				require.NoError(test, err)
+
+				verifier, err := powSigning.NewVerifier(testKey)
+				require.NoError(test, err)
+
+				clock := pow.NewFixedClock(testMoment)
+				return MiddlewareParams{
+					ChallengeTemplate: func(
+						request *http.Request,
+					) (*pow.ChallengeBuilder, error) {
+						builder := pow.NewChallengeBuilder().
+							SetLeadingZeroBitCount(leadingZeroBitCount).
+							SetCreatedAt(createdAt).
+							SetTTL(ttl).
+							SetResource(powValueTypes.NewResource(request.URL)).
+							SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+							SetHash(hash).
+							SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+								"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+									":{{ .Challenge.SerializedPayload.ToString }}" +
+									":{{ .Nonce.ToString }}",
+							))
+						return builder, nil
+					},
+					Signer:   signer,
+					Verifier: verifier,
+					Clock:    mo.Some[pow.Clock](clock),
+					ExpectedResource: func(
+						request *http.Request,
+					) powValueTypes.Resource {
+						return powValueTypes.NewResource(request.URL)
+					},
+					ReplayGuard: mo.Some(powReplayGuard.NewGuard(
+						powReplayGuard.NewMemoryStore(powReplayGuard.MemoryStoreParams{
+							Clock: mo.Some[pow.Clock](clock),
+						}),
+					)),
+				}
+			},
+			prepareRequests: func(test *testing.T) []*http.Request {
+				leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+				require.NoError(test, err)
+
+				createdAt, err := powValueTypes.NewCreatedAt(testMoment)
+				require.NoError(test, err)
+
+				ttl, err := powValueTypes.NewTTL(time.Hour)
+				require.NoError(test, err)
+
+				resource, err := url.Parse("https://example.com/another")
+				require.NoError(test, err)
+
+				hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+				require.NoError(test, err)
+
+				challenge, err := pow.NewChallengeBuilder().
+					SetLeadingZeroBitCount(leadingZeroBitCount).
+					SetCreatedAt(createdAt).
+					SetTTL(ttl).
+					SetResource(powValueTypes.NewResource(resource)).
+					SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+					SetHash(hash).
+					SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+							":{{ .Challenge.SerializedPayload.ToString }}" +
+							":{{ .Nonce.ToString }}",
+					)).
+					Build()
+				require.NoError(test, err)
+
+				signer, err := powSigning.NewSigner(testKey)
+				require.NoError(test, err)
+
+				signedChallenge, err := signer.Sign(challenge)
+				require.NoError(test, err)
+
+				solution, err := signedChallenge.Solve(
+					context.Background(),
+					pow.SolveParams{},
+				)
+				require.NoError(test, err)
+
+				encodedSolution, err := EncodeSolution(solution)
+				require.NoError(test, err)
+
+				request := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+				request.Header.Set(SolutionHeader, encodedSolution)
+
+				return []*http.Request{request}
+			},
+			wantStatusCode:      http.StatusForbidden,
+			wantRejectionCode:   mo.Some(RejectionCodeResourceMismatch),
+			wantIssuedChallenge: true,
+		},
+		{
+			name: "error/replayed solution",
+			prepareParams: func(test *testing.T) MiddlewareParams {
+				leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+				require.NoError(test, err)
+
+				createdAt, err := powValueTypes.NewCreatedAt(testMoment)
+				require.NoError(test, err)
+
+				ttl, err := powValueTypes.NewTTL(time.Hour)
+				require.NoError(test, err)
+
+				hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+				require.NoError(test, err)
+
+				signer, err := powSigning.NewSigner(testKey)
+				require.NoError(test, err)
+
+				verifier, err := powSigning.NewVerifier(testKey)
+				require.NoError(test, err)
+
+				clock := pow.NewFixedClock(testMoment)
+				return MiddlewareParams{
+					ChallengeTemplate: func(
+						request *http.Request,
+					) (*pow.ChallengeBuilder, error) {
+						builder := pow.NewChallengeBuilder().
+							SetLeadingZeroBitCount(leadingZeroBitCount).
+							SetCreatedAt(createdAt).
+							SetTTL(ttl).
+							SetResource(powValueTypes.NewResource(request.URL)).
+							SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+							SetHash(hash).
+							SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+								"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+									":{{ .Challenge.SerializedPayload.ToString }}" +
+									":{{ .Nonce.ToString }}",
+							))
+						return builder, nil
+					},
+					Signer:   signer,
+					Verifier: verifier,
+					Clock:    mo.Some[pow.Clock](clock),
+					ExpectedResource: func(
+						request *http.Request,
+					) powValueTypes.Resource {
+						return powValueTypes.NewResource(request.URL)
+					},
+					ReplayGuard: mo.Some(powReplayGuard.NewGuard(
+						powReplayGuard.NewMemoryStore(powReplayGuard.MemoryStoreParams{
+							Clock: mo.Some[pow.Clock](clock),
+						}),
+					)),
+				}
+			},
+			prepareRequests: func(test *testing.T) []*http.Request {
+				leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+				require.NoError(test, err)
+
+				createdAt, err := powValueTypes.NewCreatedAt(testMoment)
+				require.NoError(test, err)
+
+				ttl, err := powValueTypes.NewTTL(time.Hour)
+				require.NoError(test, err)
+
+				resource, err := url.Parse("https://example.com/")
+				require.NoError(test, err)
+
+				hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+				require.NoError(test, err)
+
+				challenge, err := pow.NewChallengeBuilder().
+					SetLeadingZeroBitCount(leadingZeroBitCount).
+					SetCreatedAt(createdAt).
+					SetTTL(ttl).
+					SetResource(powValueTypes.NewResource(resource)).
+					SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+					SetHash(hash).
+					SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+							":{{ .Challenge.SerializedPayload.ToString }}" +
+							":{{ .Nonce.ToString }}",
+					)).
+					Build()
+				require.NoError(test, err)
+
+				signer, err := powSigning.NewSigner(testKey)
+				require.NoError(test, err)
+
+				signedChallenge, err := signer.Sign(challenge)
+				require.NoError(test, err)
+
+				solution, err := signedChallenge.Solve(
+					context.Background(),
+					pow.SolveParams{},
+				)
+				require.NoError(test, err)
+
+				encodedSolution, err := EncodeSolution(solution)
+				require.NoError(test, err)
+
+				requests := make([]*http.Request, 2)
+				for index := range requests {
+					request := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+					request.Header.Set(SolutionHeader, encodedSolution)
+
+					requests[index] = request
+				}
+
+				return requests
+			},
+			wantStatusCode:      http.StatusForbidden,
+			wantRejectionCode:   mo.Some(RejectionCodeReplayedSolution),
+			wantIssuedChallenge: true,
+		},
+		{
+			name: "error/invalid solution",
+			prepareParams: func(test *testing.T) MiddlewareParams {
+				leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+				require.NoError(test, err)
+
+				createdAt, err := powValueTypes.NewCreatedAt(testMoment)
+				require.NoError(test, err)
+
+				ttl, err := powValueTypes.NewTTL(time.Hour)
+				require.NoError(test, err)
+
+				hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+				require.NoError(test, err)
+
+				signer, err := powSigning.NewSigner(testKey)
+				require.NoError(test, err)
+
+				verifier, err := powSigning.NewVerifier(testKey)
+				require.NoError(test, err)
+
+				clock := pow.NewFixedClock(testMoment)
+				return MiddlewareParams{
+					ChallengeTemplate: func(
+						request *http.Request,
+					) (*pow.ChallengeBuilder, error) {
+						builder := pow.NewChallengeBuilder().
+							SetLeadingZeroBitCount(leadingZeroBitCount).
+							SetCreatedAt(createdAt).
+							SetTTL(ttl).
+							SetResource(powValueTypes.NewResource(request.URL)).
+							SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+							SetHash(hash).
+							SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+								"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+									":{{ .Challenge.SerializedPayload.ToString }}" +
+									":{{ .Nonce.ToString }}",
+							))
+						return builder, nil
+					},
+					Signer:   signer,
+					Verifier: verifier,
+					Clock:    mo.Some[pow.Clock](clock),
+					ExpectedResource: func(
+						request *http.Request,
+					) powValueTypes.Resource {
+						return powValueTypes.NewResource(request.URL)
+					},
+					ReplayGuard: mo.Some(powReplayGuard.NewGuard(
+						powReplayGuard.NewMemoryStore(powReplayGuard.MemoryStoreParams{
+							Clock: mo.Some[pow.Clock](clock),
+						}),
+					)),
+				}
+			},
+			prepareRequests: func(test *testing.T) []*http.Request {
+				leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+				require.NoError(test, err)
+
+				createdAt, err := powValueTypes.NewCreatedAt(testMoment)
+				require.NoError(test, err)
+
+				ttl, err := powValueTypes.NewTTL(time.Hour)
+				require.NoError(test, err)
+
+				resource, err := url.Parse("https://example.com/")
+				require.NoError(test, err)
+
+				hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+				require.NoError(test, err)
+
+				challenge, err := pow.NewChallengeBuilder().
+					SetLeadingZeroBitCount(leadingZeroBitCount).
+					SetCreatedAt(createdAt).
+					SetTTL(ttl).
+					SetResource(powValueTypes.NewResource(resource)).
+					SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+					SetHash(hash).
+					SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+							":{{ .Challenge.SerializedPayload.ToString }}" +
+							":{{ .Nonce.ToString }}",
+					)).
+					Build()
+				require.NoError(test, err)
+
+				signer, err := powSigning.NewSigner(testKey)
+				require.NoError(test, err)
+
+				signedChallenge, err := signer.Sign(challenge)
+				require.NoError(test, err)
+
+				solution, err := signedChallenge.Solve(
+					context.Background(),
+					pow.SolveParams{},
+				)
+				require.NoError(test, err)
+
+				hashSum, err := powValueTypes.ParseHashSum(
+					strings.Repeat("00", sha256.Size),
+				)
+				require.NoError(test, err)
+
+				invalidSolution, err := pow.NewSolutionBuilder().
+					SetChallenge(solution.Challenge()).
+					SetNonce(solution.Nonce()).
+					SetHashSum(hashSum).
+					Build()
+				require.NoError(test, err)
+
+				encodedSolution, err := EncodeSolution(invalidSolution)
+				require.NoError(test, err)
+
+				request := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+				request.Header.Set(SolutionHeader, encodedSolution)
+
+				return []*http.Request{request}
+			},
+			wantStatusCode:      http.StatusForbidden,
+			wantRejectionCode:   mo.Some(RejectionCodeInvalidSolution),
+			wantIssuedChallenge: true,
+		},
+		{
+			name: "error/unable to issue the challenge",
+			prepareParams: func(test *testing.T) MiddlewareParams {
+				signer, err := powSigning.NewSigner(testKey)
+				require.NoError(test, err)
+
+				verifier, err := powSigning.NewVerifier(testKey)
+				require.NoError(test, err)
+
+				clock := pow.NewFixedClock(testMoment)
+				return MiddlewareParams{
+					ChallengeTemplate: func(
+						request *http.Request,
+					) (*pow.ChallengeBuilder, error) {
+						return nil, errors.New("dummy error")
+					},
+					Signer:   signer,
+					Verifier: verifier,
+					Clock:    mo.Some[pow.Clock](clock),
+					ExpectedResource: func(
+						request *http.Request,
+					) powValueTypes.Resource {
+						return powValueTypes.NewResource(request.URL)
+					},
+					ReplayGuard: mo.Some(powReplayGuard.NewGuard(
+						powReplayGuard.NewMemoryStore(powReplayGuard.MemoryStoreParams{
+							Clock: mo.Some[pow.Clock](clock),
+						}),
+					)),
+				}
+			},
+			prepareRequests: func(test *testing.T) []*http.Request {
+				request := httptest.NewRequest(http.MethodGet, "https://example.com/", nil)
+				return []*http.Request{request}
+			},
+			wantStatusCode:      http.StatusInternalServerError,
+			wantRejectionCode:   mo.Some(RejectionCodeInternalError),
+			wantIssuedChallenge: false,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			middleware, err := NewMiddleware(data.prepareParams(test))
+			require.NoError(test, err)
+
+			handler := middleware.Wrap(http.HandlerFunc(func(
+				writer http.ResponseWriter,
+				request *http.Request,
+			) {
+				_, isPresent := SolutionFromContext(request.Context())
+				assert.True(test, isPresent)
+
+				writer.WriteHeader(http.StatusOK)
+			}))
+
+			var recorder *httptest.ResponseRecorder
+			for _, request := range data.prepareRequests(test) {
+				recorder = httptest.NewRecorder()
+				handler.ServeHTTP(recorder, request)
+			}
+
+			assert.Equal(test, data.wantStatusCode, recorder.Code)
+			if wantRejectionCode, isPresent := data.wantRejectionCode.Get(); isPresent {
+				var rejection Rejection
+				err := json.Unmarshal(recorder.Body.Bytes(), &rejection)
+				require.NoError(test, err)
+
+				assert.Equal(test, wantRejectionCode, rejection.Code)
+				assert.NotEmpty(test, rejection.Message)
+				assert.Equal(
+					test,
+					data.wantIssuedChallenge,
+					rejection.Challenge.IsPresent(),
+				)
+			}
+			if data.wantIssuedChallenge {
+				challenge, err := DecodeChallenge(
+					recorder.Header().Get(ChallengeHeader),
+					powValueTypes.DefaultHashRegistry,
+				)
+				require.NoError(test, err)
+
+				verifier, err := powSigning.NewVerifier(testKey)
+				require.NoError(test, err)
+
+				err = verifier.VerifyChallenge(challenge)
+				assert.NoError(test, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package powHTTP
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+
+	"github.com/samber/mo"
+	pow "github.com/thewizardplusplus/go-pow"
+	powSigning "github.com/thewizardplusplus/go-pow/signing"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+const (
+	ChallengeHeader = "X-PoW-Challenge"
+	SolutionHeader  = "X-PoW-Solution"
+)
+
+type RejectionCode string
+
+const (
+	RejectionCodeSolutionRequired  RejectionCode = "solution_required"
+	RejectionCodeMalformedSolution RejectionCode = "malformed_solution"
+	RejectionCodeChallengeExpired  RejectionCode = "challenge_expired"
+	RejectionCodeInvalidSignature  RejectionCode = "invalid_signature"
+	RejectionCodeResourceMismatch  RejectionCode = "resource_mismatch"
+	RejectionCodeReplayedSolution  RejectionCode = "replayed_solution"
+	RejectionCodeInvalidSolution   RejectionCode = "invalid_solution"
+	RejectionCodeInternalError     RejectionCode = "internal_error"
+)
+
+type Rejection struct {
+	Code      RejectionCode            `json:"code"`
+	Message   string                   `json:"message"`
+	Challenge mo.Option[pow.Challenge] `json:"challenge"`
+}
+
+func EncodeChallenge(challenge pow.Challenge) (string, error) {
+	return encodeHeaderValue(challenge)
+}
+
+func DecodeChallenge(
+	value string,
+	hashRegistry *powValueTypes.HashRegistry,
+) (pow.Challenge, error) {
+	data, err := decodeHeaderValue(value)
+	if err != nil {
+		return pow.Challenge{}, err
+	}
+
+	challenge, err := pow.UnmarshalChallengeJSON(data, hashRegistry)
+	if err != nil {
+		return pow.Challenge{}, fmt.Errorf(
+			"unable to unmarshal the challenge: %w",
+			err,
+		)
+	}
+
+	return challenge, nil
+}
+
+func EncodeSolution(solution pow.Solution) (string, error) {
+	return encodeHeaderValue(solution)
+}
+
+func DecodeSolution(
+	value string,
+	hashRegistry *powValueTypes.HashRegistry,
+) (pow.Solution, error) {
+	data, err := decodeHeaderValue(value)
+	if err != nil {
+		return pow.Solution{}, err
+	}
+
+	solution, err := pow.UnmarshalSolutionJSON(data, hashRegistry)
+	if err != nil {
+		return pow.Solution{}, fmt.Errorf(
+			"unable to unmarshal the solution: %w",
+			err,
+		)
+	}
+
+	return solution, nil
+}
+
+// the signature of the challenge is checked before the latter is built,
+// so the hash data layout of a forged challenge is never parsed or executed
+func DecodeSignedSolution(
+	value string,
+	verifier powSigning.Verifier,
+	hashRegistry *powValueTypes.HashRegistry,
+) (pow.Solution, error) {
+	data, err := decodeHeaderValue(value)
+	if err != nil {
+		return pow.Solution{}, err
+	}
+
+	solution, err := verifier.UnmarshalSolutionJSON(data, hashRegistry)
+	if err != nil {
+		return pow.Solution{}, fmt.Errorf(
+			"unable to unmarshal the signed solution: %w",
+			err,
+		)
+	}
+
+	return solution, nil
+}
+
+// header values are encoded by Base64, because the JSON representation
+// may contain characters that aren't allowed in headers
+func encodeHeaderValue(value json.Marshaler) (string, error) {
+	data, err := value.MarshalJSON()
+	if err != nil {
+		return "", fmt.Errorf("unable to marshal the value: %w", err)
+	}
+
+	return base64.RawURLEncoding.EncodeToString(data), nil
+}
+
+func decodeHeaderValue(value string) ([]byte, error) {
+	data, err := base64.RawURLEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode the Base64 value: %w", err)
+	}
+
+	return data, nil
+}
@@ -0,0 +1,340 @@
+package powHTTP
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pow "github.com/thewizardplusplus/go-pow"
+	powErrors "github.com/thewizardplusplus/go-pow/errors"
+	powSigning "github.com/thewizardplusplus/go-pow/signing"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+func TestEncodeChallenge_roundTrip(test *testing.T) {
+	leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+	require.NoError(test, err)
+
+	createdAt, err := powValueTypes.NewCreatedAt(testMoment)
+	require.NoError(test, err)
+
+	ttl, err := powValueTypes.NewTTL(time.Hour)
+	require.NoError(test, err)
+
+	resource, err := url.Parse("https://example.com/")
+	require.NoError(test, err)
+
+	hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+	require.NoError(test, err)
+
+	challenge, err := pow.NewChallengeBuilder().
+		SetLeadingZeroBitCount(leadingZeroBitCount).
+		SetCreatedAt(createdAt).
+		SetTTL(ttl).
+		SetResource(powValueTypes.NewResource(resource)).
+		SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+		SetHash(hash).
+		SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+			"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+				":{{ .Challenge.SerializedPayload.ToString }}" +
+				":{{ .Nonce.ToString }}",
+		)).
+		Build()
+	require.NoError(test, err)
+
+	signer, err := powSigning.NewSigner(testKey)
+	require.NoError(test, err)
+
+	signedChallenge, err := signer.Sign(challenge)
+	require.NoError(test, err)
+
+	encodedChallenge, err := EncodeChallenge(signedChallenge)
+	require.NoError(test, err)
+
+	got, err := DecodeChallenge(
+		encodedChallenge,
+		powValueTypes.DefaultHashRegistry,
+	)
+	require.NoError(test, err)
+
+	wantData, err := signedChallenge.MarshalJSON()
+	require.NoError(test, err)
+
+	gotData, err := got.MarshalJSON()
+	require.NoError(test, err)
+
+	assert.JSONEq(test, string(wantData), string(gotData))
+}
+
+func TestEncodeSolution_roundTrip(test *testing.T) {
+	leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+	require.NoError(test, err)
+
+	createdAt, err := powValueTypes.NewCreatedAt(testMoment)
+	require.NoError(test, err)
+
+	ttl, err := powValueTypes.NewTTL(time.Hour)
+	require.NoError(test, err)
+
+	resource, err := url.Parse("https://example.com/")
+	require.NoError(test, err)
+
+	hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+	require.NoError(test, err)
+
+	challenge, err := pow.NewChallengeBuilder().
+		SetLeadingZeroBitCount(leadingZeroBitCount).
+		SetCreatedAt(createdAt).
+		SetTTL(ttl).
+		SetResource(powValueTypes.NewResource(resource)).
+		SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+		SetHash(hash).
+		SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+			"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+				":{{ .Challenge.SerializedPayload.ToString }}" +
+				":{{ .Nonce.ToString }}",
+		)).
+		Build()
+	require.NoError(test, err)
+
+	signer, err := powSigning.NewSigner(testKey)
+	require.NoError(test, err)
+
+	signedChallenge, err := signer.Sign(challenge)
+	require.NoError(test, err)
+
+	solution, err := signedChallenge.Solve(context.Background(), pow.SolveParams{})
+	require.NoError(test, err)
+
+	encodedSolution, err := EncodeSolution(solution)
+	require.NoError(test, err)
+
+	got, err := DecodeSolution(
+		encodedSolution,
+		powValueTypes.DefaultHashRegistry,
+	)
+	require.NoError(test, err)
+
+	wantData, err := solution.MarshalJSON()
+	require.NoError(test, err)
+
+	gotData, err := got.MarshalJSON()
+	require.NoError(test, err)
+
+	assert.JSONEq(test, string(wantData), string(gotData))
+}
+
+func TestDecodeChallenge(test *testing.T) {
+	type args struct {
+		value        string
+		hashRegistry *powValueTypes.HashRegistry
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "error/invalid Base64",
+			args: args{
+				value:        "!invalid!",
+				hashRegistry: powValueTypes.DefaultHashRegistry,
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/invalid JSON",
+			args: args{
+				value:        "aW52YWxpZA", // "invalid"
+				hashRegistry: powValueTypes.DefaultHashRegistry,
+			},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			_, err := DecodeChallenge(data.args.value, data.args.hashRegistry)
+
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestDecodeSolution(test *testing.T) {
+	type args struct {
+		value        string
+		hashRegistry *powValueTypes.HashRegistry
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "error/invalid Base64",
+			args: args{
+				value:        "!invalid!",
+				hashRegistry: powValueTypes.DefaultHashRegistry,
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/invalid JSON",
+			args: args{
+				value:        "aW52YWxpZA", // "invalid"
+				hashRegistry: powValueTypes.DefaultHashRegistry,
+			},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			_, err := DecodeSolution(data.args.value, data.args.hashRegistry)
+
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestDecodeSignedSolution(test *testing.T) {
+	type args struct {
+		value        string
+		hashRegistry *powValueTypes.HashRegistry
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				value: func() string {
+					leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+					require.NoError(test, err)
+
+					hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+					require.NoError(test, err)
+
+					challenge, err := pow.NewChallengeBuilder().
+						SetLeadingZeroBitCount(leadingZeroBitCount).
+						SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+						SetHash(hash).
+						SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+							"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+								":{{ .Challenge.SerializedPayload.ToString }}" +
+								":{{ .Nonce.ToString }}",
+						)).
+						Build()
+					require.NoError(test, err)
+
+					signer, err := powSigning.NewSigner(testKey)
+					require.NoError(test, err)
+
+					signedChallenge, err := signer.Sign(challenge)
+					require.NoError(test, err)
+
+					solution, err := signedChallenge.Solve(
+						context.Background(),
+						pow.SolveParams{},
+					)
+					require.NoError(test, err)
+
+					value, err := EncodeSolution(solution)
+					require.NoError(test, err)
+
+					return value
+				}(),
+				hashRegistry: powValueTypes.DefaultHashRegistry,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/invalid Base64",
+			args: args{
+				value:        "!invalid!",
+				hashRegistry: powValueTypes.DefaultHashRegistry,
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/unsigned challenge",
+			args: args{
+				value: func() string {
+					leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+					require.NoError(test, err)
+
+					hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+					require.NoError(test, err)
+
+					challenge, err := pow.NewChallengeBuilder().
+						SetLeadingZeroBitCount(leadingZeroBitCount).
+						SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+						SetHash(hash).
+						SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+							"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+								":{{ .Challenge.SerializedPayload.ToString }}" +
+								":{{ .Nonce.ToString }}",
+						)).
+						Build()
+					require.NoError(test, err)
+
+					solution, err := challenge.Solve(
+						context.Background(),
+						pow.SolveParams{},
+					)
+					require.NoError(test, err)
+
+					value, err := EncodeSolution(solution)
+					require.NoError(test, err)
+
+					return value
+				}(),
+				hashRegistry: powValueTypes.DefaultHashRegistry,
+			},
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrInvalidSignature)
+			},
+		},
+		{
+			name: "error/forged challenge",
+			args: args{
+				// the layout is invalid, so the error would be different,
+				// if it were parsed before the signature check
+				value: base64.RawURLEncoding.EncodeToString([]byte(`{
+					"challenge": {
+						"leading_zero_bit_count": 0,
+						"serialized_payload": "dummy",
+						"hash_name": "SHA-256",
+						"hash_data_layout": "{{ .Nonce",
+						"hash_data_layout_kind": "text"
+					},
+					"nonce": "0"
+				}`)),
+				hashRegistry: powValueTypes.DefaultHashRegistry,
+			},
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrInvalidSignature)
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			verifier, err := powSigning.NewVerifier(testKey)
+			require.NoError(test, err)
+
+			_, err = DecodeSignedSolution(
+				data.args.value,
+				verifier,
+				data.args.hashRegistry,
+			)
+
+			data.wantErr(test, err)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package powHTTP
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/samber/mo"
+	pow "github.com/thewizardplusplus/go-pow"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+const (
+	// a larger body isn't worth reading, as it's cheaper
+	// to close the connection than to download the body
+	maxDrainedResponseBodySize = 64 << 10
+)
+
+type RoundTripperParams struct {
+	Transport    mo.Option[http.RoundTripper]
+	SolveParams  pow.SolveParams
+	HashRegistry mo.Option[*powValueTypes.HashRegistry]
+}
+
+type RoundTripper struct {
+	params RoundTripperParams
+}
+
+func NewRoundTripper(params RoundTripperParams) RoundTripper {
+	return RoundTripper{
+		params: params,
+	}
+}
+
+func (roundTripper RoundTripper) RoundTrip(
+	request *http.Request,
+) (*http.Response, error) {
+	transport := roundTripper.params.Transport.OrElse(http.DefaultTransport)
+	response, err := transport.RoundTrip(request)
+	if err != nil {
+		return nil, err
+	}
+
+	rawChallenge := response.Header.Get(ChallengeHeader)
+	if rawChallenge == "" || isSuccessfulResponse(response) {
+		return response, nil
+	}
+
+	// the request body has been already consumed,
+	// so it's impossible to retry the request without its copy
+	if request.Body != nil && request.Body != http.NoBody &&
+		request.GetBody == nil {
+		return response, nil
+	}
+
+	closeResponse(response)
+
+	challenge, err := DecodeChallenge(
+		rawChallenge,
+		roundTripper.params.HashRegistry.
+			OrElse(powValueTypes.DefaultHashRegistry),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode the challenge: %w", err)
+	}
+
+	solution, err := challenge.Solve(
+		request.Context(),
+		roundTripper.params.SolveParams,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to solve the challenge: %w", err)
+	}
+
+	encodedSolution, err := EncodeSolution(solution)
+	if err != nil {
+		return nil, fmt.Errorf("unable to encode the solution: %w", err)
+	}
+
+	retriedRequest, err := cloneRequest(request)
+	if err != nil {
+		return nil, fmt.Errorf("unable to clone the request: %w", err)
+	}
+
+	retriedRequest.Header.Set(SolutionHeader, encodedSolution)
+	return transport.RoundTrip(retriedRequest)
+}
+
+func isSuccessfulResponse(response *http.Response) bool {
+	return response.StatusCode >= 200 && response.StatusCode < 300
+}
+
+func closeResponse(response *http.Response) {
+	// the body is drained to allow the connection to be reused,
+	// but only up to the limit, so a server cannot make the client
+	// download an arbitrarily large body
+	io.Copy( //nolint:errcheck
+		io.Discard,
+		io.LimitReader(response.Body, maxDrainedResponseBodySize),
+	)
+	response.Body.Close() //nolint:errcheck
+}
+
+func cloneRequest(request *http.Request) (*http.Request, error) {
+	clonedRequest := request.Clone(request.Context())
+	if request.Body == nil || request.Body == http.NoBody {
+		return clonedRequest, nil
+	}
+
+	if request.GetBody == nil {
+		return nil, errors.New("request body cannot be copied")
+	}
+
+	body, err := request.GetBody()
+	if err != nil {
+		return nil, fmt.Errorf("unable to copy the request body: %w", err)
+	}
+
+	clonedRequest.Body = body
+	return clonedRequest, nil
+}
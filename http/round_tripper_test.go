@@ -0,0 +1,443 @@
+package powHTTP
+
+import (
+	"crypto/sha256"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/samber/mo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pow "github.com/thewizardplusplus/go-pow"
+	powErrors "github.com/thewizardplusplus/go-pow/errors"
+	powSigning "github.com/thewizardplusplus/go-pow/signing"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+func TestRoundTripper_RoundTrip(test *testing.T) {
+	type fields struct {
+		params RoundTripperParams
+	}
+
+	for _, data := range []struct {
+		name           string
+		fields         fields
+		prepareHandler func(test *testing.T) http.Handler
+		prepareRequest func(test *testing.T, url string) *http.Request
+		wantStatusCode int
+		wantBody       string
+		wantErr        assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/without a body",
+			fields: fields{
+				params: RoundTripperParams{},
+			},
+			prepareHandler: func(test *testing.T) http.Handler {
+				leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+				require.NoError(test, err)
+
+				createdAt, err := powValueTypes.NewCreatedAt(testMoment)
+				require.NoError(test, err)
+
+				ttl, err := powValueTypes.NewTTL(time.Hour)
+				require.NoError(test, err)
+
+				hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+				require.NoError(test, err)
+
+				signer, err := powSigning.NewSigner(testKey)
+				require.NoError(test, err)
+
+				verifier, err := powSigning.NewVerifier(testKey)
+				require.NoError(test, err)
+
+				middleware, err := NewMiddleware(MiddlewareParams{
+					ChallengeTemplate: func(
+						request *http.Request,
+					) (*pow.ChallengeBuilder, error) {
+						builder := pow.NewChallengeBuilder().
+							SetLeadingZeroBitCount(leadingZeroBitCount).
+							SetCreatedAt(createdAt).
+							SetTTL(ttl).
+							SetResource(powValueTypes.NewResource(request.URL)).
+							SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+							SetHash(hash).
+							SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+								"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+									":{{ .Challenge.SerializedPayload.ToString }}" +
+									":{{ .Nonce.ToString }}",
+							))
+						return builder, nil
+					},
+					Signer:   signer,
+					Verifier: verifier,
+					Clock:    mo.Some[pow.Clock](pow.NewFixedClock(testMoment)),
+					ExpectedResource: func(
+						request *http.Request,
+					) powValueTypes.Resource {
+						return powValueTypes.NewResource(request.URL)
+					},
+				})
+				require.NoError(test, err)
+
+				return middleware.Wrap(http.HandlerFunc(func(
+					writer http.ResponseWriter,
+					request *http.Request,
+				) {
+					io.Copy(writer, request.Body) //nolint:errcheck
+				}))
+			},
+			prepareRequest: func(test *testing.T, url string) *http.Request {
+				request, err := http.NewRequest(http.MethodGet, url, nil)
+				require.NoError(test, err)
+
+				return request
+			},
+			wantStatusCode: http.StatusOK,
+			wantBody:       "",
+			wantErr:        assert.NoError,
+		},
+		{
+			name: "success/with a body",
+			fields: fields{
+				params: RoundTripperParams{},
+			},
+			prepareHandler: func(test *testing.T) http.Handler {
+				leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+				require.NoError(test, err)
+
+				createdAt, err := powValueTypes.NewCreatedAt(testMoment)
+				require.NoError(test, err)
+
+				ttl, err := powValueTypes.NewTTL(time.Hour)
+				require.NoError(test, err)
+
+				hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+				require.NoError(test, err)
+
+				signer, err := powSigning.NewSigner(testKey)
+				require.NoError(test, err)
+
+				verifier, err := powSigning.NewVerifier(testKey)
+				require.NoError(test, err)
+
+				middleware, err := NewMiddleware(MiddlewareParams{
+					ChallengeTemplate: func(
+						request *http.Request,
+					) (*pow.ChallengeBuilder, error) {
+						builder := pow.NewChallengeBuilder().
+							SetLeadingZeroBitCount(leadingZeroBitCount).
+							SetCreatedAt(createdAt).
+							SetTTL(ttl).
+							SetResource(powValueTypes.NewResource(request.URL)).
+							SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+							SetHash(hash).
+							SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+								"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+									":{{ .Challenge.SerializedPayload.ToString }}" +
+									":{{ .Nonce.ToString }}",
+							))
+						return builder, nil
+					},
+					Signer:   signer,
+					Verifier: verifier,
+					Clock:    mo.Some[pow.Clock](pow.NewFixedClock(testMoment)),
+					ExpectedResource: func(
+						request *http.Request,
+					) powValueTypes.Resource {
+						return powValueTypes.NewResource(request.URL)
+					},
+				})
+				require.NoError(test, err)
+
+				return middleware.Wrap(http.HandlerFunc(func(
+					writer http.ResponseWriter,
+					request *http.Request,
+				) {
+					io.Copy(writer, request.Body) //nolint:errcheck
+				}))
+			},
+			prepareRequest: func(test *testing.T, url string) *http.Request {
+				request, err :=
+					http.NewRequest(http.MethodPost, url, strings.NewReader("dummy"))
+				require.NoError(test, err)
+
+				return request
+			},
+			wantStatusCode: http.StatusOK,
+			wantBody:       "dummy",
+			wantErr:        assert.NoError,
+		},
+		{
+			name: "success/without a challenge",
+			fields: fields{
+				params: RoundTripperParams{},
+			},
+			prepareHandler: func(test *testing.T) http.Handler {
+				return http.HandlerFunc(func(
+					writer http.ResponseWriter,
+					request *http.Request,
+				) {
+					writer.WriteHeader(http.StatusUnauthorized)
+				})
+			},
+			prepareRequest: func(test *testing.T, url string) *http.Request {
+				request, err := http.NewRequest(http.MethodGet, url, nil)
+				require.NoError(test, err)
+
+				return request
+			},
+			wantStatusCode: http.StatusUnauthorized,
+			wantBody:       "",
+			wantErr:        assert.NoError,
+		},
+		{
+			name: "success/with a body that cannot be copied",
+			fields: fields{
+				params: RoundTripperParams{},
+			},
+			prepareHandler: func(test *testing.T) http.Handler {
+				leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+				require.NoError(test, err)
+
+				createdAt, err := powValueTypes.NewCreatedAt(testMoment)
+				require.NoError(test, err)
+
+				ttl, err := powValueTypes.NewTTL(time.Hour)
+				require.NoError(test, err)
+
+				hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+				require.NoError(test, err)
+
+				signer, err := powSigning.NewSigner(testKey)
+				require.NoError(test, err)
+
+				verifier, err := powSigning.NewVerifier(testKey)
+				require.NoError(test, err)
+
+				middleware, err := NewMiddleware(MiddlewareParams{
+					ChallengeTemplate: func(
+						request *http.Request,
+					) (*pow.ChallengeBuilder, error) {
+						builder := pow.NewChallengeBuilder().
+							SetLeadingZeroBitCount(leadingZeroBitCount).
+							SetCreatedAt(createdAt).
+							SetTTL(ttl).
+							SetResource(powValueTypes.NewResource(request.URL)).
+							SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+							SetHash(hash).
+							SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+								"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+									":{{ .Challenge.SerializedPayload.ToString }}" +
+									":{{ .Nonce.ToString }}",
+							))
+						return builder, nil
+					},
+					Signer:   signer,
+					Verifier: verifier,
+					Clock:    mo.Some[pow.Clock](pow.NewFixedClock(testMoment)),
+					ExpectedResource: func(
+						request *http.Request,
+					) powValueTypes.Resource {
+						return powValueTypes.NewResource(request.URL)
+					},
+				})
+				require.NoError(test, err)
+
+				return middleware.Wrap(http.HandlerFunc(func(
+					writer http.ResponseWriter,
+					request *http.Request,
+				) {
+					io.Copy(writer, request.Body) //nolint:errcheck
+				}))
+			},
+			prepareRequest: func(test *testing.T, url string) *http.Request {
+				request, err := http.NewRequest(
+					http.MethodPost,
+					url,
+					io.NopCloser(strings.NewReader("dummy")),
+				)
+				require.NoError(test, err)
+
+				return request
+			},
+			wantStatusCode: http.StatusUnauthorized,
+			wantBody: `{"code":"solution_required",` +
+				`"message":"solution is required",` +
+				`"challenge":{` +
+				`"leading_zero_bit_count":5,` +
+				`"target":null,` +
+				`"created_at":"2000-01-02T03:04:05.000000006Z",` +
+				`"ttl":"1h0m0s",` +
+				`"resource":"/",` +
+				`"serialized_payload":"dummy",` +
+				`"hash_name":"SHA-256",` +
+				`"hash_data_layout":"{{.Challenge.LeadingZeroBitCount.ToInt}}` +
+				`:{{.Challenge.SerializedPayload.ToString}}` +
+				`:{{.Nonce.ToString}}",` +
+				`"hash_data_layout_kind":"text",` +
+				`"nonce_encoding":null,` +
+				`"signature":{"key_id":"key-1",` +
+				`"value":"d4ee4844cbfc41ad9cda5cb39e6575656d25da45e514c5a65769289266b7a5a9"},` + //nolint:lll
+				`"required_hash_data_fields":null` +
+				"}}\n",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/unable to decode the challenge",
+			fields: fields{
+				params: RoundTripperParams{},
+			},
+			prepareHandler: func(test *testing.T) http.Handler {
+				return http.HandlerFunc(func(
+					writer http.ResponseWriter,
+					request *http.Request,
+				) {
+					writer.Header().Set(ChallengeHeader, "invalid")
+					writer.WriteHeader(http.StatusUnauthorized)
+				})
+			},
+			prepareRequest: func(test *testing.T, url string) *http.Request {
+				request, err := http.NewRequest(http.MethodGet, url, nil)
+				require.NoError(test, err)
+
+				return request
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/unable to solve the challenge",
+			fields: fields{
+				params: RoundTripperParams{
+					SolveParams: pow.SolveParams{
+						MaxAttemptCount: mo.Some(10),
+					},
+				},
+			},
+			prepareHandler: func(test *testing.T) http.Handler {
+				leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(100)
+				require.NoError(test, err)
+
+				createdAt, err := powValueTypes.NewCreatedAt(testMoment)
+				require.NoError(test, err)
+
+				ttl, err := powValueTypes.NewTTL(time.Hour)
+				require.NoError(test, err)
+
+				hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+				require.NoError(test, err)
+
+				signer, err := powSigning.NewSigner(testKey)
+				require.NoError(test, err)
+
+				verifier, err := powSigning.NewVerifier(testKey)
+				require.NoError(test, err)
+
+				middleware, err := NewMiddleware(MiddlewareParams{
+					ChallengeTemplate: func(
+						request *http.Request,
+					) (*pow.ChallengeBuilder, error) {
+						builder := pow.NewChallengeBuilder().
+							SetLeadingZeroBitCount(leadingZeroBitCount).
+							SetCreatedAt(createdAt).
+							SetTTL(ttl).
+							SetResource(powValueTypes.NewResource(request.URL)).
+							SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+							SetHash(hash).
+							SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+								"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+									":{{ .Challenge.SerializedPayload.ToString }}" +
+									":{{ .Nonce.ToString }}",
+							))
+						return builder, nil
+					},
+					Signer:   signer,
+					Verifier: verifier,
+					Clock:    mo.Some[pow.Clock](pow.NewFixedClock(testMoment)),
+					ExpectedResource: func(
+						request *http.Request,
+					) powValueTypes.Resource {
+						return powValueTypes.NewResource(request.URL)
+					},
+				})
+				require.NoError(test, err)
+
+				return middleware.Wrap(http.HandlerFunc(func(
+					writer http.ResponseWriter,
+					request *http.Request,
+				) {
+					io.Copy(writer, request.Body) //nolint:errcheck
+				}))
+			},
+			prepareRequest: func(test *testing.T, url string) *http.Request {
+				request, err := http.NewRequest(http.MethodGet, url, nil)
+				require.NoError(test, err)
+
+				return request
+			},
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrTaskInterruption)
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			server := httptest.NewServer(data.prepareHandler(test))
+			defer server.Close()
+
+			client := &http.Client{
+				Transport: NewRoundTripper(data.fields.params),
+			}
+			response, err := client.Do(data.prepareRequest(test, server.URL))
+
+			data.wantErr(test, err)
+			if err == nil {
+				defer response.Body.Close()
+
+				body, err := io.ReadAll(response.Body)
+				require.NoError(test, err)
+
+				assert.Equal(test, data.wantStatusCode, response.StatusCode)
+				assert.Equal(test, data.wantBody, string(body))
+			}
+		})
+	}
+}
+
+func Test_closeResponse(test *testing.T) {
+	type args struct {
+		bodySize int
+	}
+
+	for _, data := range []struct {
+		name              string
+		args              args
+		wantUnreadBodyLen int
+	}{
+		{
+			name: "success/body within the limit",
+			args: args{
+				bodySize: 23,
+			},
+			wantUnreadBodyLen: 0,
+		},
+		{
+			name: "success/body over the limit",
+			args: args{
+				bodySize: maxDrainedResponseBodySize + 23,
+			},
+			wantUnreadBodyLen: 23,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			body := strings.NewReader(strings.Repeat("x", data.args.bodySize))
+			closeResponse(&http.Response{Body: io.NopCloser(body)})
+
+			assert.Equal(test, data.wantUnreadBodyLen, body.Len())
+		})
+	}
+}
@@ -0,0 +1,24 @@
+package powHooks
+
+import (
+	powRawChallenge "github.com/thewizardplusplus/go-pow/internal/raw-challenge"
+)
+
+// the hook gives the subpackages access to the raw challenge of a solution
+// before the latter is built; it's registered by the `pow` package
+// on its initialization, because this package cannot import the `pow` package
+var unmarshalRawSolutionChallenge func(
+	data []byte,
+) (powRawChallenge.RawChallenge, error)
+
+func RegisterUnmarshalRawSolutionChallenge(
+	hook func(data []byte) (powRawChallenge.RawChallenge, error),
+) {
+	unmarshalRawSolutionChallenge = hook
+}
+
+func UnmarshalRawSolutionChallenge(
+	data []byte,
+) (powRawChallenge.RawChallenge, error) {
+	return unmarshalRawSolutionChallenge(data)
+}
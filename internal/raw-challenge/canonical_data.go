@@ -0,0 +1,55 @@
+package powRawChallenge
+
+import (
+	"encoding/binary"
+	"hash"
+	"strconv"
+	"strings"
+
+	"github.com/samber/mo"
+)
+
+const (
+	canonicalDataVersion = "go-pow/challenge/v2"
+)
+
+// every field is prefixed with its length (and optional fields
+// with their presence flag), so that different challenges cannot produce
+// the same canonical data by moving a separator between the fields;
+// the signature itself isn't included
+func WriteCanonicalData(writer hash.Hash, challenge RawChallenge) {
+	writeCanonicalField(writer, canonicalDataVersion)
+	writeCanonicalField(writer, strconv.Itoa(challenge.LeadingZeroBitCount))
+	writeCanonicalOptionalField(writer, challenge.Target)
+	writeCanonicalOptionalField(writer, challenge.CreatedAt)
+	writeCanonicalOptionalField(writer, challenge.TTL)
+	writeCanonicalOptionalField(writer, challenge.Resource)
+	writeCanonicalField(writer, challenge.SerializedPayload)
+	writeCanonicalField(writer, challenge.HashName)
+	writeCanonicalField(writer, challenge.HashDataLayoutKind)
+	writeCanonicalField(writer, challenge.HashDataLayout)
+	writeCanonicalOptionalField(writer, challenge.NonceEncoding)
+
+	// the field names don't contain commas, so their list is unambiguous
+	requiredHashDataFields := mo.None[string]()
+	if values, isPresent := challenge.RequiredHashDataFields.Get(); isPresent {
+		requiredHashDataFields = mo.Some(strings.Join(values, ","))
+	}
+	writeCanonicalOptionalField(writer, requiredHashDataFields)
+}
+
+func writeCanonicalOptionalField(writer hash.Hash, field mo.Option[string]) {
+	value, isPresent := field.Get()
+	if !isPresent {
+		writer.Write([]byte{0})
+		return
+	}
+
+	writer.Write([]byte{1})
+	writeCanonicalField(writer, value)
+}
+
+func writeCanonicalField(writer hash.Hash, value string) {
+	writer.Write(binary.BigEndian.AppendUint64(nil, uint64(len(value))))
+	writer.Write([]byte(value))
+}
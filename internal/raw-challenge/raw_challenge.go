@@ -0,0 +1,30 @@
+package powRawChallenge
+
+import (
+	"github.com/samber/mo"
+)
+
+// the raw challenge holds the serialized fields of a challenge as is,
+// i.e. without their parsing; it's the single list of these fields
+// for both the JSON representation and the canonical data, so a field
+// cannot be serialized without being signed
+type RawChallenge struct {
+	LeadingZeroBitCount int                     `json:"leading_zero_bit_count"` //nolint:lll
+	Target              mo.Option[string]       `json:"target"`
+	CreatedAt           mo.Option[string]       `json:"created_at"`
+	TTL                 mo.Option[string]       `json:"ttl"`
+	Resource            mo.Option[string]       `json:"resource"`
+	SerializedPayload   string                  `json:"serialized_payload"`
+	HashName            string                  `json:"hash_name"`
+	HashDataLayout      string                  `json:"hash_data_layout"`
+	HashDataLayoutKind  string                  `json:"hash_data_layout_kind"` //nolint:lll
+	NonceEncoding       mo.Option[string]       `json:"nonce_encoding"`
+	Signature           mo.Option[RawSignature] `json:"signature"`
+
+	RequiredHashDataFields mo.Option[[]string] `json:"required_hash_data_fields"` //nolint:lll
+}
+
+type RawSignature struct {
+	KeyID string `json:"key_id"`
+	Value string `json:"value"`
+}
@@ -0,0 +1,167 @@
+package powIssuer
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/samber/mo"
+	pow "github.com/thewizardplusplus/go-pow"
+	powSigning "github.com/thewizardplusplus/go-pow/signing"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+type IssuerParams struct {
+	Rules         []PolicyRule
+	DefaultPolicy mo.Option[Policy]
+	Signer        mo.Option[powSigning.Signer]
+	Clock         mo.Option[pow.Clock]
+}
+
+func (params IssuerParams) validate() error {
+	var errs []error
+	if len(params.Rules) == 0 && params.DefaultPolicy.IsAbsent() {
+		errs = append(errs, errors.New("at least one policy is required"))
+	}
+	for ruleIndex, rule := range params.Rules {
+		if err := rule.validate(); err != nil {
+			errs = append(errs, fmt.Errorf(
+				"unable to validate the policy rule #%d: %w",
+				ruleIndex,
+				err,
+			))
+		}
+	}
+	if defaultPolicy, isPresent := params.DefaultPolicy.Get(); isPresent {
+		if err := defaultPolicy.validate(); err != nil {
+			errs = append(errs, fmt.Errorf(
+				"unable to validate the default policy: %w",
+				err,
+			))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+type Issuer struct {
+	params IssuerParams
+}
+
+func NewIssuer(params IssuerParams) (Issuer, error) {
+	if err := params.validate(); err != nil {
+		return Issuer{}, fmt.Errorf("unable to validate the parameters: %w", err)
+	}
+
+	issuer := Issuer{
+		params: params,
+	}
+	return issuer, nil
+}
+
+// the rules are checked in order, and the first matching one wins;
+// if none of them matches, the default policy is used
+func (issuer Issuer) Policy(resource powValueTypes.Resource) (Policy, error) {
+	for _, rule := range issuer.params.Rules {
+		if rule.isMatch(resource) {
+			return rule.Policy, nil
+		}
+	}
+
+	defaultPolicy, isPresent := issuer.params.DefaultPolicy.Get()
+	if !isPresent {
+		return Policy{}, errors.New("no policy matches the resource")
+	}
+
+	return defaultPolicy, nil
+}
+
+// the builder isn't signed, so it's suitable
+// as a challenge template of the HTTP middleware
+func (issuer Issuer) ChallengeBuilder(
+	resource powValueTypes.Resource,
+) (*pow.ChallengeBuilder, error) {
+	policy, err := issuer.Policy(resource)
+	if err != nil {
+		return nil, fmt.Errorf("unable to select the policy: %w", err)
+	}
+
+	serializedPayload := powValueTypes.NewSerializedPayload("")
+	if payloadGenerator, isPresent := policy.PayloadGenerator.Get(); isPresent {
+		serializedPayload, err = payloadGenerator()
+		if err != nil {
+			return nil, fmt.Errorf("unable to generate the payload: %w", err)
+		}
+	}
+
+	builder := pow.NewChallengeBuilder().
+		SetResource(resource).
+		SetSerializedPayload(serializedPayload).
+		SetHash(policy.Hash).
+		SetHashDataLayout(policy.HashDataLayout)
+	// the policy is validated, so exactly one of these functions is present
+	if targetFunc, isPresent := policy.Target.Get(); isPresent {
+		target, err := targetFunc(resource)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get the target: %w", err)
+		}
+
+		builder.SetTarget(target)
+	}
+	if difficultyFunc, isPresent := policy.Difficulty.Get(); isPresent {
+		leadingZeroBitCount, err := difficultyFunc(resource)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get the difficulty: %w", err)
+		}
+
+		builder.SetLeadingZeroBitCount(leadingZeroBitCount)
+	}
+	if nonceEncoding, isPresent := policy.NonceEncoding.Get(); isPresent {
+		builder.SetNonceEncoding(nonceEncoding)
+	}
+	if ttl, isPresent := policy.TTL.Get(); isPresent {
+		createdAt, err := powValueTypes.NewCreatedAt(
+			issuer.params.Clock.OrElse(pow.SystemClock{}).Now(),
+		)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to construct the `CreatedAt` timestamp: %w",
+				err,
+			)
+		}
+
+		builder.
+			SetCreatedAt(createdAt).
+			SetTTL(ttl)
+	}
+
+	return builder, nil
+}
+
+func (issuer Issuer) Issue(
+	resource powValueTypes.Resource,
+) (pow.Challenge, error) {
+	builder, err := issuer.ChallengeBuilder(resource)
+	if err != nil {
+		return pow.Challenge{}, fmt.Errorf(
+			"unable to make the challenge builder: %w",
+			err,
+		)
+	}
+
+	challenge, err := builder.Build()
+	if err != nil {
+		return pow.Challenge{}, fmt.Errorf("unable to build the challenge: %w", err)
+	}
+
+	signer, isPresent := issuer.params.Signer.Get()
+	if !isPresent {
+		return challenge, nil
+	}
+
+	signedChallenge, err := signer.Sign(challenge)
+	if err != nil {
+		return pow.Challenge{}, fmt.Errorf("unable to sign the challenge: %w", err)
+	}
+
+	return signedChallenge, nil
+}
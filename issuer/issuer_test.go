@@ -0,0 +1,804 @@
+package powIssuer
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/samber/mo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pow "github.com/thewizardplusplus/go-pow"
+	powSigning "github.com/thewizardplusplus/go-pow/signing"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+var (
+	testMoment = time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC)
+	testKey    = powSigning.Key{ID: "key-1", Secret: []byte("secret-1")}
+)
+
+func TestNewIssuer(test *testing.T) {
+	type args struct {
+		params IssuerParams
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/rules only",
+			args: args{
+				params: IssuerParams{
+					Rules: []PolicyRule{
+						{
+							Pattern: "/login",
+							Policy: func() Policy {
+								hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+								require.NoError(test, err)
+
+								leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(10)
+								require.NoError(test, err)
+
+								return Policy{
+									Hash: hash,
+									HashDataLayout: powValueTypes.MustParseHashDataLayout(
+										"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+											":{{ .Challenge.SerializedPayload.ToString }}" +
+											":{{ .Nonce.ToString }}",
+									),
+									Difficulty: mo.Some(ConstantDifficulty(leadingZeroBitCount)),
+								}
+							}(),
+						},
+					},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/default policy only",
+			args: args{
+				params: IssuerParams{
+					DefaultPolicy: mo.Some(func() Policy {
+						hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+						require.NoError(test, err)
+
+						leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(10)
+						require.NoError(test, err)
+
+						return Policy{
+							Hash: hash,
+							HashDataLayout: powValueTypes.MustParseHashDataLayout(
+								"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+									":{{ .Challenge.SerializedPayload.ToString }}" +
+									":{{ .Nonce.ToString }}",
+							),
+							Difficulty: mo.Some(ConstantDifficulty(leadingZeroBitCount)),
+						}
+					}()),
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/no policies",
+			args: args{
+				params: IssuerParams{},
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/invalid pattern",
+			args: args{
+				params: IssuerParams{
+					Rules: []PolicyRule{
+						{
+							Pattern: "/login[",
+							Policy: func() Policy {
+								hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+								require.NoError(test, err)
+
+								leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(10)
+								require.NoError(test, err)
+
+								return Policy{
+									Hash: hash,
+									HashDataLayout: powValueTypes.MustParseHashDataLayout(
+										"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+											":{{ .Challenge.SerializedPayload.ToString }}" +
+											":{{ .Nonce.ToString }}",
+									),
+									Difficulty: mo.Some(ConstantDifficulty(leadingZeroBitCount)),
+								}
+							}(),
+						},
+					},
+				},
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/rule without a difficulty function",
+			args: args{
+				params: IssuerParams{
+					Rules: []PolicyRule{{Pattern: "/login", Policy: Policy{}}},
+				},
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/rule with both difficulty and target functions",
+			args: args{
+				params: IssuerParams{
+					Rules: []PolicyRule{
+						{
+							Pattern: "/login",
+							Policy: func() Policy {
+								hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+								require.NoError(test, err)
+
+								leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(10)
+								require.NoError(test, err)
+
+								policy := Policy{
+									Hash: hash,
+									HashDataLayout: powValueTypes.MustParseHashDataLayout(
+										"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+											":{{ .Challenge.SerializedPayload.ToString }}" +
+											":{{ .Nonce.ToString }}",
+									),
+									Difficulty: mo.Some(ConstantDifficulty(leadingZeroBitCount)),
+								}
+								policy.Target = mo.Some(ConstantTarget(
+									powValueTypes.NewTargetFromBitIndex(
+										powValueTypes.TargetBitIndex{},
+									),
+								))
+
+								return policy
+							}(),
+						},
+					},
+				},
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/rule with a nil difficulty function",
+			args: args{
+				params: IssuerParams{
+					Rules: []PolicyRule{
+						{
+							Pattern: "/login",
+							Policy: Policy{
+								Difficulty: mo.Some[DifficultyFunc](nil),
+							},
+						},
+					},
+				},
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/rule with a nil target function",
+			args: args{
+				params: IssuerParams{
+					Rules: []PolicyRule{
+						{
+							Pattern: "/login",
+							Policy: Policy{
+								Target: mo.Some[TargetFunc](nil),
+							},
+						},
+					},
+				},
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/default policy without a difficulty function",
+			args: args{
+				params: IssuerParams{
+					DefaultPolicy: mo.Some(Policy{}),
+				},
+			},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			_, err := NewIssuer(data.args.params)
+
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestIssuer_ChallengeBuilder(test *testing.T) {
+	type fields struct {
+		params IssuerParams
+	}
+	type args struct {
+		resource string
+	}
+
+	for _, data := range []struct {
+		name                    string
+		fields                  fields
+		args                    args
+		wantLeadingZeroBitCount int
+		wantArbitraryTarget     mo.Option[powValueTypes.Target]
+		wantTTL                 mo.Option[powValueTypes.TTL]
+		wantErr                 assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/first matching rule",
+			fields: fields{
+				params: IssuerParams{
+					Rules: []PolicyRule{
+						{
+							Pattern: "/login",
+							Policy: func() Policy {
+								hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+								require.NoError(test, err)
+
+								leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(20)
+								require.NoError(test, err)
+
+								policy := Policy{
+									Hash: hash,
+									HashDataLayout: powValueTypes.MustParseHashDataLayout(
+										"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+											":{{ .Challenge.SerializedPayload.ToString }}" +
+											":{{ .Nonce.ToString }}",
+									),
+									Difficulty: mo.Some(ConstantDifficulty(leadingZeroBitCount)),
+								}
+								policy.TTL = mo.Some(func() powValueTypes.TTL {
+									value, err := powValueTypes.NewTTL(time.Minute)
+									require.NoError(test, err)
+
+									return value
+								}())
+
+								return policy
+							}(),
+						},
+						{
+							Pattern: "/search",
+							Policy: func() Policy {
+								hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+								require.NoError(test, err)
+
+								leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(10)
+								require.NoError(test, err)
+
+								return Policy{
+									Hash: hash,
+									HashDataLayout: powValueTypes.MustParseHashDataLayout(
+										"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+											":{{ .Challenge.SerializedPayload.ToString }}" +
+											":{{ .Nonce.ToString }}",
+									),
+									Difficulty: mo.Some(ConstantDifficulty(leadingZeroBitCount)),
+								}
+							}(),
+						},
+						{
+							Pattern: "/*",
+							Policy: func() Policy {
+								hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+								require.NoError(test, err)
+
+								leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+								require.NoError(test, err)
+
+								return Policy{
+									Hash: hash,
+									HashDataLayout: powValueTypes.MustParseHashDataLayout(
+										"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+											":{{ .Challenge.SerializedPayload.ToString }}" +
+											":{{ .Nonce.ToString }}",
+									),
+									Difficulty: mo.Some(ConstantDifficulty(leadingZeroBitCount)),
+								}
+							}(),
+						},
+					},
+					Clock: mo.Some[pow.Clock](pow.NewFixedClock(testMoment)),
+				},
+			},
+			args: args{
+				resource: "https://example.com/login?next=%2F",
+			},
+			wantLeadingZeroBitCount: 20,
+			wantTTL: mo.Some(func() powValueTypes.TTL {
+				value, err := powValueTypes.NewTTL(time.Minute)
+				require.NoError(test, err)
+
+				return value
+			}()),
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/another rule",
+			fields: fields{
+				params: IssuerParams{
+					Rules: []PolicyRule{
+						{
+							Pattern: "/login",
+							Policy: func() Policy {
+								hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+								require.NoError(test, err)
+
+								leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(20)
+								require.NoError(test, err)
+
+								policy := Policy{
+									Hash: hash,
+									HashDataLayout: powValueTypes.MustParseHashDataLayout(
+										"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+											":{{ .Challenge.SerializedPayload.ToString }}" +
+											":{{ .Nonce.ToString }}",
+									),
+									Difficulty: mo.Some(ConstantDifficulty(leadingZeroBitCount)),
+								}
+								policy.TTL = mo.Some(func() powValueTypes.TTL {
+									value, err := powValueTypes.NewTTL(time.Minute)
+									require.NoError(test, err)
+
+									return value
+								}())
+
+								return policy
+							}(),
+						},
+						{
+							Pattern: "/search",
+							Policy: func() Policy {
+								hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+								require.NoError(test, err)
+
+								leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(10)
+								require.NoError(test, err)
+
+								return Policy{
+									Hash: hash,
+									HashDataLayout: powValueTypes.MustParseHashDataLayout(
+										"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+											":{{ .Challenge.SerializedPayload.ToString }}" +
+											":{{ .Nonce.ToString }}",
+									),
+									Difficulty: mo.Some(ConstantDifficulty(leadingZeroBitCount)),
+								}
+							}(),
+						},
+						{
+							Pattern: "/*",
+							Policy: func() Policy {
+								hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+								require.NoError(test, err)
+
+								leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+								require.NoError(test, err)
+
+								return Policy{
+									Hash: hash,
+									HashDataLayout: powValueTypes.MustParseHashDataLayout(
+										"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+											":{{ .Challenge.SerializedPayload.ToString }}" +
+											":{{ .Nonce.ToString }}",
+									),
+									Difficulty: mo.Some(ConstantDifficulty(leadingZeroBitCount)),
+								}
+							}(),
+						},
+					},
+				},
+			},
+			args: args{
+				resource: "https://example.com/search",
+			},
+			wantLeadingZeroBitCount: 10,
+			wantTTL:                 mo.None[powValueTypes.TTL](),
+			wantErr:                 assert.NoError,
+		},
+		{
+			name: "success/wildcard rule",
+			fields: fields{
+				params: IssuerParams{
+					Rules: []PolicyRule{
+						{
+							Pattern: "/search",
+							Policy: func() Policy {
+								hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+								require.NoError(test, err)
+
+								leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(10)
+								require.NoError(test, err)
+
+								return Policy{
+									Hash: hash,
+									HashDataLayout: powValueTypes.MustParseHashDataLayout(
+										"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+											":{{ .Challenge.SerializedPayload.ToString }}" +
+											":{{ .Nonce.ToString }}",
+									),
+									Difficulty: mo.Some(ConstantDifficulty(leadingZeroBitCount)),
+								}
+							}(),
+						},
+						{
+							Pattern: "/api/*",
+							Policy: func() Policy {
+								hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+								require.NoError(test, err)
+
+								leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+								require.NoError(test, err)
+
+								return Policy{
+									Hash: hash,
+									HashDataLayout: powValueTypes.MustParseHashDataLayout(
+										"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+											":{{ .Challenge.SerializedPayload.ToString }}" +
+											":{{ .Nonce.ToString }}",
+									),
+									Difficulty: mo.Some(ConstantDifficulty(leadingZeroBitCount)),
+								}
+							}(),
+						},
+					},
+				},
+			},
+			args: args{
+				resource: "https://example.com/api/items",
+			},
+			wantLeadingZeroBitCount: 5,
+			wantTTL:                 mo.None[powValueTypes.TTL](),
+			wantErr:                 assert.NoError,
+		},
+		{
+			name: "success/default policy",
+			fields: fields{
+				params: IssuerParams{
+					Rules: []PolicyRule{
+						{
+							Pattern: "/search",
+							Policy: func() Policy {
+								hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+								require.NoError(test, err)
+
+								leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(10)
+								require.NoError(test, err)
+
+								return Policy{
+									Hash: hash,
+									HashDataLayout: powValueTypes.MustParseHashDataLayout(
+										"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+											":{{ .Challenge.SerializedPayload.ToString }}" +
+											":{{ .Nonce.ToString }}",
+									),
+									Difficulty: mo.Some(ConstantDifficulty(leadingZeroBitCount)),
+								}
+							}(),
+						},
+					},
+					DefaultPolicy: mo.Some(func() Policy {
+						hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+						require.NoError(test, err)
+
+						leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(1)
+						require.NoError(test, err)
+
+						return Policy{
+							Hash: hash,
+							HashDataLayout: powValueTypes.MustParseHashDataLayout(
+								"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+									":{{ .Challenge.SerializedPayload.ToString }}" +
+									":{{ .Nonce.ToString }}",
+							),
+							Difficulty: mo.Some(ConstantDifficulty(leadingZeroBitCount)),
+						}
+					}()),
+				},
+			},
+			args: args{
+				resource: "https://example.com/search/items",
+			},
+			wantLeadingZeroBitCount: 1,
+			wantTTL:                 mo.None[powValueTypes.TTL](),
+			wantErr:                 assert.NoError,
+		},
+		{
+			name: "success/target function",
+			fields: fields{
+				params: IssuerParams{
+					DefaultPolicy: mo.Some(Policy{
+						Hash: powValueTypes.NewHash(sha256.New()),
+						HashDataLayout: powValueTypes.MustParseHashDataLayout(
+							"{{ .Challenge.Target.ToString }}:{{ .Nonce.ToString }}",
+						),
+						Target: mo.Some(ConstantTarget(func() powValueTypes.Target {
+							// the target is 1.5 times greater than the one
+							// of 6 leading zero bits
+							value, err := powValueTypes.NewTarget(
+								big.NewInt(0).Lsh(big.NewInt(3), 249),
+							)
+							require.NoError(test, err)
+
+							return value
+						}())),
+					}),
+				},
+			},
+			args: args{
+				resource: "https://example.com/login",
+			},
+			wantLeadingZeroBitCount: 5,
+			wantArbitraryTarget: mo.Some(func() powValueTypes.Target {
+				value, err := powValueTypes.NewTarget(
+					big.NewInt(0).Lsh(big.NewInt(3), 249),
+				)
+				require.NoError(test, err)
+
+				return value
+			}()),
+			wantTTL: mo.None[powValueTypes.TTL](),
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/no matching policy",
+			fields: fields{
+				params: IssuerParams{
+					Rules: []PolicyRule{
+						{
+							Pattern: "/search",
+							Policy: func() Policy {
+								hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+								require.NoError(test, err)
+
+								leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(10)
+								require.NoError(test, err)
+
+								return Policy{
+									Hash: hash,
+									HashDataLayout: powValueTypes.MustParseHashDataLayout(
+										"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+											":{{ .Challenge.SerializedPayload.ToString }}" +
+											":{{ .Nonce.ToString }}",
+									),
+									Difficulty: mo.Some(ConstantDifficulty(leadingZeroBitCount)),
+								}
+							}(),
+						},
+					},
+				},
+			},
+			args: args{
+				resource: "https://example.com/login",
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/difficulty failure",
+			fields: fields{
+				params: IssuerParams{
+					DefaultPolicy: mo.Some(Policy{
+						Hash: powValueTypes.NewHash(sha256.New()),
+						HashDataLayout: powValueTypes.MustParseHashDataLayout(
+							"{{ .Nonce.ToString }}",
+						),
+						Difficulty: mo.Some[DifficultyFunc](func(
+							resource powValueTypes.Resource,
+						) (powValueTypes.LeadingZeroBitCount, error) {
+							return powValueTypes.LeadingZeroBitCount{},
+								errors.New("dummy")
+						}),
+					}),
+				},
+			},
+			args: args{
+				resource: "https://example.com/login",
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/target failure",
+			fields: fields{
+				params: IssuerParams{
+					DefaultPolicy: mo.Some(Policy{
+						Hash: powValueTypes.NewHash(sha256.New()),
+						HashDataLayout: powValueTypes.MustParseHashDataLayout(
+							"{{ .Nonce.ToString }}",
+						),
+						Target: mo.Some[TargetFunc](func(
+							resource powValueTypes.Resource,
+						) (powValueTypes.Target, error) {
+							return powValueTypes.Target{}, errors.New("dummy")
+						}),
+					}),
+				},
+			},
+			args: args{
+				resource: "https://example.com/login",
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/payload generation failure",
+			fields: fields{
+				params: IssuerParams{
+					DefaultPolicy: mo.Some(Policy{
+						Hash: powValueTypes.NewHash(sha256.New()),
+						HashDataLayout: powValueTypes.MustParseHashDataLayout(
+							"{{ .Nonce.ToString }}",
+						),
+						Difficulty: mo.Some(ConstantDifficulty(
+							func() powValueTypes.LeadingZeroBitCount {
+								value, err := powValueTypes.NewLeadingZeroBitCount(5)
+								require.NoError(test, err)
+
+								return value
+							}(),
+						)),
+						PayloadGenerator: mo.Some[PayloadGenerator](func() (
+							powValueTypes.SerializedPayload,
+							error,
+						) {
+							return powValueTypes.SerializedPayload{}, errors.New("dummy")
+						}),
+					}),
+				},
+			},
+			args: args{
+				resource: "https://example.com/login",
+			},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			issuer, err := NewIssuer(data.fields.params)
+			require.NoError(test, err)
+
+			resource, err := powValueTypes.ParseResource(data.args.resource)
+			require.NoError(test, err)
+
+			builder, err := issuer.ChallengeBuilder(resource)
+			data.wantErr(test, err)
+			if err != nil {
+				return
+			}
+
+			challenge, err := builder.Build()
+			require.NoError(test, err)
+
+			assert.Equal(
+				test,
+				data.wantLeadingZeroBitCount,
+				challenge.LeadingZeroBitCount().ToInt(),
+			)
+			assert.Equal(
+				test,
+				data.wantArbitraryTarget,
+				challenge.ArbitraryTarget(),
+			)
+			assert.Equal(test, mo.Some(resource), challenge.Resource())
+			assert.Equal(test, data.wantTTL, challenge.TTL())
+			if data.wantTTL.IsPresent() {
+				createdAt, isPresent := challenge.CreatedAt().Get()
+				require.True(test, isPresent)
+
+				assert.Equal(test, testMoment, createdAt.ToTime())
+			}
+		})
+	}
+}
+
+func TestIssuer_Issue(test *testing.T) {
+	signer, err := powSigning.NewSigner(testKey)
+	require.NoError(test, err)
+
+	verifier, err := powSigning.NewVerifier(testKey)
+	require.NoError(test, err)
+
+	payloadGenerator, err := NewRandomPayloadGenerator(RandomPayloadParams{
+		SizeInBytes: 16,
+	})
+	require.NoError(test, err)
+
+	nonceEncoding, err :=
+		powValueTypes.NewNonceEncoding(powValueTypes.NonceEncodingKindBase36)
+	require.NoError(test, err)
+
+	ttl, err := powValueTypes.NewTTL(time.Minute)
+	require.NoError(test, err)
+
+	hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+	require.NoError(test, err)
+
+	leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+	require.NoError(test, err)
+
+	policy := Policy{
+		Hash: hash,
+		HashDataLayout: powValueTypes.MustParseHashDataLayout(
+			"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+				":{{ .Challenge.SerializedPayload.ToString }}" +
+				":{{ .Nonce.ToString }}",
+		),
+		Difficulty: mo.Some(ConstantDifficulty(leadingZeroBitCount)),
+	}
+	policy.TTL = mo.Some(ttl)
+	policy.PayloadGenerator = mo.Some(payloadGenerator)
+	policy.NonceEncoding = mo.Some(nonceEncoding)
+
+	issuer, err := NewIssuer(IssuerParams{
+		Rules:  []PolicyRule{{Pattern: "/login", Policy: policy}},
+		Signer: mo.Some(signer),
+	})
+	require.NoError(test, err)
+
+	resource, err := powValueTypes.ParseResource("https://example.com/login")
+	require.NoError(test, err)
+
+	challenge, err := issuer.Issue(resource)
+	require.NoError(test, err)
+
+	anotherChallenge, err := issuer.Issue(resource)
+	require.NoError(test, err)
+
+	assert.True(test, challenge.Signature().IsPresent())
+	assert.Equal(test, mo.Some(nonceEncoding), challenge.NonceEncoding())
+	assert.Len(test, challenge.SerializedPayload().ToString(), 32)
+	assert.NotEqual(
+		test,
+		challenge.SerializedPayload(),
+		anotherChallenge.SerializedPayload(),
+	)
+
+	solution, err := challenge.Solve(context.Background(), pow.SolveParams{})
+	require.NoError(test, err)
+
+	err = verifier.VerifySolution(solution, pow.VerifyParams{
+		ExpectedResource: mo.Some(resource),
+	})
+	assert.NoError(test, err)
+}
+
+func TestIssuer_Issue_withoutSigner(test *testing.T) {
+	issuer, err := NewIssuer(IssuerParams{
+		DefaultPolicy: mo.Some(func() Policy {
+			hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+			require.NoError(test, err)
+
+			leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+			require.NoError(test, err)
+
+			return Policy{
+				Hash: hash,
+				HashDataLayout: powValueTypes.MustParseHashDataLayout(
+					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+						":{{ .Challenge.SerializedPayload.ToString }}" +
+						":{{ .Nonce.ToString }}",
+				),
+				Difficulty: mo.Some(ConstantDifficulty(leadingZeroBitCount)),
+			}
+		}()),
+	})
+	require.NoError(test, err)
+
+	resource, err := powValueTypes.ParseResource("https://example.com/")
+	require.NoError(test, err)
+
+	challenge, err := issuer.Issue(resource)
+	require.NoError(test, err)
+
+	assert.True(test, challenge.Signature().IsAbsent())
+	assert.Equal(test, "", challenge.SerializedPayload().ToString())
+}
@@ -0,0 +1,43 @@
+package powIssuer
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/samber/mo"
+	powErrors "github.com/thewizardplusplus/go-pow/errors"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+type PayloadGenerator func() (powValueTypes.SerializedPayload, error)
+
+type RandomPayloadParams struct {
+	SizeInBytes  int
+	RandomReader mo.Option[io.Reader]
+}
+
+// the generated payload is a random salt encoded in hex
+func NewRandomPayloadGenerator(
+	params RandomPayloadParams,
+) (PayloadGenerator, error) {
+	if params.SizeInBytes <= 0 {
+		return nil, errors.New("payload size must be positive")
+	}
+
+	randomReader := params.RandomReader.OrElse(rand.Reader)
+	generator := func() (powValueTypes.SerializedPayload, error) {
+		salt := make([]byte, params.SizeInBytes)
+		if _, err := io.ReadFull(randomReader, salt); err != nil {
+			return powValueTypes.SerializedPayload{}, fmt.Errorf(
+				"unable to read the random bytes: %w",
+				errors.Join(err, powErrors.ErrIO),
+			)
+		}
+
+		return powValueTypes.NewSerializedPayload(hex.EncodeToString(salt)), nil
+	}
+	return generator, nil
+}
@@ -0,0 +1,90 @@
+package powIssuer
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/samber/mo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	powErrors "github.com/thewizardplusplus/go-pow/errors"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+func TestNewRandomPayloadGenerator(test *testing.T) {
+	type args struct {
+		params RandomPayloadParams
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		want    powValueTypes.SerializedPayload
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				params: RandomPayloadParams{
+					SizeInBytes: 4,
+					RandomReader: mo.Some[io.Reader](
+						bytes.NewReader([]byte{1, 2, 3, 4}),
+					),
+				},
+			},
+			want:    powValueTypes.NewSerializedPayload("01020304"),
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/reading failure",
+			args: args{
+				params: RandomPayloadParams{
+					SizeInBytes:  4,
+					RandomReader: mo.Some[io.Reader](bytes.NewReader([]byte{1, 2})),
+				},
+			},
+			want: powValueTypes.SerializedPayload{},
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrIO)
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			generator, err := NewRandomPayloadGenerator(data.args.params)
+			require.NoError(test, err)
+
+			got, err := generator()
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestNewRandomPayloadGenerator_withDefaultReader(test *testing.T) {
+	generator, err := NewRandomPayloadGenerator(RandomPayloadParams{
+		SizeInBytes: 16,
+	})
+	require.NoError(test, err)
+
+	payload, err := generator()
+	require.NoError(test, err)
+
+	anotherPayload, err := generator()
+	require.NoError(test, err)
+
+	assert.Len(test, payload.ToString(), 32)
+	assert.NotEqual(test, payload, anotherPayload)
+	assert.Empty(test, strings.Trim(payload.ToString(), "0123456789abcdef"))
+}
+
+func TestNewRandomPayloadGenerator_withInvalidSize(test *testing.T) {
+	generator, err := NewRandomPayloadGenerator(RandomPayloadParams{
+		SizeInBytes: 0,
+	})
+
+	assert.Nil(test, generator)
+	assert.Error(test, err)
+}
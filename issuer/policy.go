@@ -0,0 +1,91 @@
+package powIssuer
+
+import (
+	"errors"
+	"fmt"
+	"path"
+
+	"github.com/samber/mo"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+type DifficultyFunc func(
+	resource powValueTypes.Resource,
+) (powValueTypes.LeadingZeroBitCount, error)
+
+func ConstantDifficulty(
+	leadingZeroBitCount powValueTypes.LeadingZeroBitCount,
+) DifficultyFunc {
+	return func(
+		resource powValueTypes.Resource,
+	) (powValueTypes.LeadingZeroBitCount, error) {
+		return leadingZeroBitCount, nil
+	}
+}
+
+// the target allows to issue challenges with an arbitrary difficulty,
+// not limited to whole leading zero bits
+type TargetFunc func(
+	resource powValueTypes.Resource,
+) (powValueTypes.Target, error)
+
+func ConstantTarget(target powValueTypes.Target) TargetFunc {
+	return func(resource powValueTypes.Resource) (powValueTypes.Target, error) {
+		return target, nil
+	}
+}
+
+type Policy struct {
+	Hash             powValueTypes.Hash
+	HashDataLayout   powValueTypes.HashDataLayout
+	TTL              mo.Option[powValueTypes.TTL]
+	Difficulty       mo.Option[DifficultyFunc]
+	Target           mo.Option[TargetFunc]
+	PayloadGenerator mo.Option[PayloadGenerator]
+	NonceEncoding    mo.Option[powValueTypes.NonceEncoding]
+}
+
+func (policy Policy) validate() error {
+	// the other fields are checked by the challenge builder on issuing
+	difficultyFunc, isDifficultyPresent := policy.Difficulty.Get()
+	targetFunc, isTargetPresent := policy.Target.Get()
+	switch {
+	case !isDifficultyPresent && !isTargetPresent:
+		return errors.New("difficulty function or target function is required")
+	case isDifficultyPresent && isTargetPresent:
+		return errors.New(
+			"only one of difficulty function and target function should be specified",
+		)
+	case isDifficultyPresent && difficultyFunc == nil:
+		return errors.New("difficulty function cannot be nil")
+	case isTargetPresent && targetFunc == nil:
+		return errors.New("target function cannot be nil")
+	}
+
+	return nil
+}
+
+// the pattern is matched against the path of the resource URL
+// using the `path.Match()` syntax
+type PolicyRule struct {
+	Pattern string
+	Policy  Policy
+}
+
+func (rule PolicyRule) validate() error {
+	if _, err := path.Match(rule.Pattern, ""); err != nil {
+		return fmt.Errorf("unable to check the pattern: %w", err)
+	}
+
+	if err := rule.Policy.validate(); err != nil {
+		return fmt.Errorf("unable to validate the policy: %w", err)
+	}
+
+	return nil
+}
+
+func (rule PolicyRule) isMatch(resource powValueTypes.Resource) bool {
+	// the pattern has already been validated, so the error is impossible
+	isMatch, _ := path.Match(rule.Pattern, resource.ToURL().Path)
+	return isMatch
+}
@@ -0,0 +1,61 @@
+package pow
+
+import (
+	"github.com/samber/mo"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+type precompiledHashDataLayout struct {
+	prefixedHash  powValueTypes.PrefixedHash
+	suffix        string
+	nonceEncoding powValueTypes.NonceEncoding
+}
+
+// the layout is precompiled only if its parts around the nonce are constant;
+// otherwise, it should be executed on each attempt
+func (entity Challenge) precompileHashDataLayout() mo.Option[precompiledHashDataLayout] { //nolint:lll
+	splitLayout, isSplit := entity.hashDataLayout.SplitByNonce()
+	if !isSplit {
+		return mo.None[precompiledHashDataLayout]()
+	}
+
+	nonce, err := powValueTypes.NewZeroNonce()
+	if err != nil {
+		return mo.None[precompiledHashDataLayout]()
+	}
+
+	data := entity.newHashData(nonce)
+	prefix, err := splitLayout.Prefix.Execute(data)
+	if err != nil {
+		return mo.None[precompiledHashDataLayout]()
+	}
+
+	suffix, err := splitLayout.Suffix.Execute(data)
+	if err != nil {
+		return mo.None[precompiledHashDataLayout]()
+	}
+
+	return mo.Some(precompiledHashDataLayout{
+		prefixedHash: entity.hash.WithPrefix(prefix),
+		suffix:       suffix,
+		nonceEncoding: entity.nonceEncoding.
+			OrElse(powValueTypes.DefaultNonceEncoding),
+	})
+}
+
+// the prefixed hash applies the hash it's made from,
+// so it's remade from the hash of the specific worker
+func (layout precompiledHashDataLayout) withHash(
+	hash powValueTypes.Hash,
+) precompiledHashDataLayout {
+	layout.prefixedHash = hash.WithPrefix(layout.prefixedHash.Prefix())
+	return layout
+}
+
+func (layout precompiledHashDataLayout) applyHashTo(
+	nonce powValueTypes.Nonce,
+) powValueTypes.HashSum {
+	return layout.prefixedHash.ApplyTo(
+		layout.nonceEncoding.Encode(nonce) + layout.suffix,
+	)
+}
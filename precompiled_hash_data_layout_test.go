@@ -0,0 +1,78 @@
+package pow
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+func TestChallenge_precompileHashDataLayout(test *testing.T) {
+	for _, data := range []struct {
+		name                string
+		hashDataLayout      powValueTypes.HashDataLayout
+		wantIsLayoutPresent bool
+	}{
+		{
+			name: "success/nonce at the end",
+			hashDataLayout: powValueTypes.MustParseHashDataLayout(
+				"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+					":{{ .Challenge.SerializedPayload.ToString }}" +
+					":{{ .Nonce.ToString }}",
+			),
+			wantIsLayoutPresent: true,
+		},
+		{
+			name: "success/nonce in the middle",
+			hashDataLayout: powValueTypes.MustParseHashDataLayout(
+				"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+					":{{ .Nonce.ToString }}" +
+					":{{ .Challenge.SerializedPayload.ToString }}",
+			),
+			wantIsLayoutPresent: true,
+		},
+		{
+			name: "failure/nonce in another form",
+			hashDataLayout: powValueTypes.MustParseHashDataLayout(
+				"{{ .Challenge.SerializedPayload.ToString }}" +
+					":{{ .Nonce.ToBigInt }}",
+			),
+			wantIsLayoutPresent: false,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+			require.NoError(test, err)
+
+			entity := Challenge{
+				leadingZeroBitCount: leadingZeroBitCount,
+				serializedPayload:   powValueTypes.NewSerializedPayload("dummy"),
+				hash:                powValueTypes.NewHashFromFactory(sha256.New),
+				hashDataLayout:      data.hashDataLayout,
+			}
+			got, isLayoutPresent := entity.precompileHashDataLayout().Get()
+
+			require.Equal(test, data.wantIsLayoutPresent, isLayoutPresent)
+			if !isLayoutPresent {
+				return
+			}
+
+			for _, rawNonce := range []int64{0, 37, 123456789} {
+				nonce, err := powValueTypes.NewNonce(big.NewInt(rawNonce))
+				require.NoError(test, err)
+
+				hashData, err := entity.hashDataLayout.Execute(ChallengeHashData{
+					Challenge: entity,
+					Nonce:     nonce,
+				})
+				require.NoError(test, err)
+
+				want := entity.hash.ApplyTo(hashData)
+				assert.Equal(test, want, got.applyHashTo(nonce))
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package pow
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/samber/mo"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+type SolveProgress struct {
+	AttemptCount int
+	CurrentNonce powValueTypes.Nonce
+	Elapsed      time.Duration
+	HashRate     float64 // attempts per second
+
+	// attempts are independent of each other, so the expected number
+	// of the remaining attempts doesn't decrease as solving goes on
+	ExpectedRemainingAttemptCount float64
+}
+
+type ProgressObserver func(progress SolveProgress)
+
+type ProgressParams struct {
+	Observer        ProgressObserver
+	AttemptInterval mo.Option[int]
+	TimeInterval    mo.Option[time.Duration]
+}
+
+func (params ProgressParams) validate() error {
+	var errs []error
+	if params.Observer == nil {
+		errs = append(errs, errors.New("progress observer is required"))
+	}
+
+	attemptInterval, isAttemptIntervalPresent := params.AttemptInterval.Get()
+	timeInterval, isTimeIntervalPresent := params.TimeInterval.Get()
+	if !isAttemptIntervalPresent && !isTimeIntervalPresent {
+		errs = append(
+			errs,
+			errors.New("attempt interval or time interval is required"),
+		)
+	}
+	if isAttemptIntervalPresent && attemptInterval <= 0 {
+		errs = append(errs, errors.New("attempt interval must be positive"))
+	}
+	if isTimeIntervalPresent && timeInterval <= 0 {
+		errs = append(errs, errors.New("time interval must be positive"))
+	}
+
+	return errors.Join(errs...)
+}
+
+type progressReporter struct {
+	params                        ProgressParams
+	startedAt                     time.Time
+	expectedRemainingAttemptCount float64
+	isReportRequested             atomic.Bool
+	mutex                         sync.Mutex
+}
+
+func newProgressReporter(
+	params ProgressParams,
+	expectedAttemptCount float64,
+) *progressReporter {
+	return &progressReporter{
+		params:                        params,
+		startedAt:                     time.Now(),
+		expectedRemainingAttemptCount: expectedAttemptCount,
+	}
+}
+
+func (reporter *progressReporter) start(ctx context.Context) {
+	timeInterval, isPresent := reporter.params.TimeInterval.Get()
+	if !isPresent {
+		return
+	}
+
+	// the ticker only requests a report, while the report itself is made
+	// by a worker, so that the worker's current nonce is available
+	go func() {
+		ticker := time.NewTicker(timeInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+
+			case <-ticker.C:
+				reporter.isReportRequested.Store(true)
+			}
+		}
+	}()
+}
+
+func (reporter *progressReporter) onAttempt(
+	attemptCount int64,
+	nonce powValueTypes.Nonce,
+) {
+	attemptInterval, isPresent := reporter.params.AttemptInterval.Get()
+	isReportDue := isPresent && attemptCount%int64(attemptInterval) == 0
+	if reporter.isReportRequested.Load() &&
+		reporter.isReportRequested.CompareAndSwap(true, false) {
+		isReportDue = true
+	}
+	if !isReportDue {
+		return
+	}
+
+	// the observer is called by the workers sequentially,
+	// so it isn't required to be safe for concurrent use
+	reporter.mutex.Lock()
+	defer reporter.mutex.Unlock()
+
+	elapsed := time.Since(reporter.startedAt)
+	reporter.params.Observer(SolveProgress{
+		AttemptCount:                  int(attemptCount),
+		CurrentNonce:                  nonce,
+		Elapsed:                       elapsed,
+		HashRate:                      makeHashRate(attemptCount, elapsed),
+		ExpectedRemainingAttemptCount: reporter.expectedRemainingAttemptCount,
+	})
+}
+
+// the elapsed time may be zero on platforms with a coarse clock,
+// so the hash rate is unknown (i.e. zero) in that case
+func makeHashRate(attemptCount int64, elapsed time.Duration) float64 {
+	if elapsed <= 0 {
+		return 0
+	}
+
+	return float64(attemptCount) / elapsed.Seconds()
+}
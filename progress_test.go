@@ -0,0 +1,243 @@
+package pow
+
+import (
+	"context"
+	"crypto/sha256"
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/samber/mo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	powErrors "github.com/thewizardplusplus/go-pow/errors"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+func TestProgressParams_validate(test *testing.T) {
+	type fields struct {
+		Observer        ProgressObserver
+		AttemptInterval mo.Option[int]
+		TimeInterval    mo.Option[time.Duration]
+	}
+
+	for _, data := range []struct {
+		name    string
+		fields  fields
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/attempt interval",
+			fields: fields{
+				Observer:        func(progress SolveProgress) {},
+				AttemptInterval: mo.Some(23),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/time interval",
+			fields: fields{
+				Observer:     func(progress SolveProgress) {},
+				TimeInterval: mo.Some(time.Second),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/both intervals",
+			fields: fields{
+				Observer:        func(progress SolveProgress) {},
+				AttemptInterval: mo.Some(23),
+				TimeInterval:    mo.Some(time.Second),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/observer is required",
+			fields: fields{
+				Observer:        nil,
+				AttemptInterval: mo.Some(23),
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/interval is required",
+			fields: fields{
+				Observer: func(progress SolveProgress) {},
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/attempt interval isn't positive",
+			fields: fields{
+				Observer:        func(progress SolveProgress) {},
+				AttemptInterval: mo.Some(0),
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/time interval isn't positive",
+			fields: fields{
+				Observer:     func(progress SolveProgress) {},
+				TimeInterval: mo.Some(-time.Second),
+			},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			params := ProgressParams{
+				Observer:        data.fields.Observer,
+				AttemptInterval: data.fields.AttemptInterval,
+				TimeInterval:    data.fields.TimeInterval,
+			}
+			err := params.validate()
+
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func Test_makeHashRate(test *testing.T) {
+	type args struct {
+		attemptCount int64
+		elapsed      time.Duration
+	}
+
+	for _, data := range []struct {
+		name string
+		args args
+		want float64
+	}{
+		{
+			name: "success/positive elapsed time",
+			args: args{
+				attemptCount: 23,
+				elapsed:      2 * time.Second,
+			},
+			want: 11.5,
+		},
+		{
+			name: "success/zero elapsed time",
+			args: args{
+				attemptCount: 23,
+				elapsed:      0,
+			},
+			want: 0,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got := makeHashRate(data.args.attemptCount, data.args.elapsed)
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
+func TestChallenge_Solve_withProgress(test *testing.T) {
+	for _, data := range []struct {
+		name              string
+		concurrencyFactor int
+	}{
+		{
+			name:              "success/single worker",
+			concurrencyFactor: 1,
+		},
+		{
+			name:              "success/several workers",
+			concurrencyFactor: 4,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(100)
+			require.NoError(test, err)
+
+			entity := Challenge{
+				leadingZeroBitCount: leadingZeroBitCount,
+				serializedPayload:   powValueTypes.NewSerializedPayload("dummy"),
+				hash:                powValueTypes.NewHashFromFactory(sha256.New),
+				hashDataLayout: powValueTypes.MustParseHashDataLayout(
+					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+						":{{ .Challenge.SerializedPayload.ToString }}" +
+						":{{ .Nonce.ToString }}",
+				),
+			}
+
+			var progresses []SolveProgress
+			_, err = entity.Solve(context.Background(), SolveParams{
+				MaxAttemptCount:   mo.Some(100),
+				ConcurrencyFactor: mo.Some(data.concurrencyFactor),
+				ProgressParams: mo.Some(ProgressParams{
+					Observer: func(progress SolveProgress) {
+						progresses = append(progresses, progress)
+					},
+					AttemptInterval: mo.Some(10),
+				}),
+			})
+
+			require.ErrorIs(test, err, powErrors.ErrTaskInterruption)
+			require.Len(test, progresses, 10)
+
+			var attemptCounts []int
+			for _, progress := range progresses {
+				attemptCounts = append(attemptCounts, progress.AttemptCount)
+
+				assert.Positive(test, progress.Elapsed)
+				assert.Positive(test, progress.HashRate)
+				assert.Equal(
+					test,
+					math.Pow(2, 100),
+					progress.ExpectedRemainingAttemptCount,
+				)
+			}
+			assert.ElementsMatch(
+				test,
+				[]int{10, 20, 30, 40, 50, 60, 70, 80, 90, 100},
+				attemptCounts,
+			)
+		})
+	}
+}
+
+func TestChallenge_Solve_withPeriodicProgress(test *testing.T) {
+	leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(100)
+	require.NoError(test, err)
+
+	entity := Challenge{
+		leadingZeroBitCount: leadingZeroBitCount,
+		serializedPayload:   powValueTypes.NewSerializedPayload("dummy"),
+		hash:                powValueTypes.NewHashFromFactory(sha256.New),
+		hashDataLayout: powValueTypes.MustParseHashDataLayout(
+			"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+				":{{ .Challenge.SerializedPayload.ToString }}" +
+				":{{ .Nonce.ToString }}",
+		),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	var mutex sync.Mutex
+	var progresses []SolveProgress
+	_, err = entity.Solve(ctx, SolveParams{
+		ProgressParams: mo.Some(ProgressParams{
+			Observer: func(progress SolveProgress) {
+				mutex.Lock()
+				defer mutex.Unlock()
+
+				progresses = append(progresses, progress)
+			},
+			TimeInterval: mo.Some(10 * time.Millisecond),
+		}),
+	})
+
+	require.ErrorIs(test, err, powErrors.ErrTaskInterruption)
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	assert.NotEmpty(test, progresses)
+	for _, progress := range progresses {
+		assert.Positive(test, progress.AttemptCount)
+		assert.Positive(test, progress.HashRate)
+	}
+}
@@ -0,0 +1,56 @@
+package powReplayGuard
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+
+	pow "github.com/thewizardplusplus/go-pow"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+type Guard struct {
+	store Store
+}
+
+func NewGuard(store Store) Guard {
+	return Guard{
+		store: store,
+	}
+}
+
+func (guard Guard) Accept(ctx context.Context, solution pow.Solution) error {
+	// the solution is expected to be already verified,
+	// so here it's only checked for being a replay
+	challenge := solution.Challenge()
+	createdAt, isCreatedAtPresent := challenge.CreatedAt().Get()
+	ttl, isTTLPresent := challenge.TTL().Get()
+	if !isCreatedAtPresent || !isTTLPresent {
+		return errors.New(
+			"challenge without `CreatedAt` timestamp and TTL " +
+				"cannot be protected from replays",
+		)
+	}
+
+	key := makeSolutionKey(solution)
+	expiresAt := createdAt.ToTime().Add(ttl.ToDuration())
+	if err := guard.store.StoreKey(ctx, key, expiresAt); err != nil {
+		return fmt.Errorf("unable to store the solution key: %w", err)
+	}
+
+	return nil
+}
+
+// the key consists of the challenge identity and the nonce; the identity
+// is a digest of the canonical data of the challenge, which, unlike
+// its JSON representation, is available for any hash; the hash sum
+// isn't included, as it's fully determined by them
+func makeSolutionKey(solution pow.Solution) string {
+	challengeIDHash := sha256.New()
+	solution.Challenge().WriteCanonicalData(challengeIDHash)
+
+	return hex.EncodeToString(challengeIDHash.Sum(nil)) + ":" +
+		powValueTypes.DefaultNonceEncoding.Encode(solution.Nonce())
+}
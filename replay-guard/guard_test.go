@@ -0,0 +1,429 @@
+package powReplayGuard
+
+import (
+	"context"
+	"crypto/sha256"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/samber/mo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pow "github.com/thewizardplusplus/go-pow"
+	powErrors "github.com/thewizardplusplus/go-pow/errors"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+func TestGuard_Accept(test *testing.T) {
+	moment := time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC)
+	for _, data := range []struct {
+		name              string
+		acceptedSolutions []pow.Solution
+		solution          pow.Solution
+		wantErr           assert.ErrorAssertionFunc
+	}{
+		{
+			name:              "success/first solution",
+			acceptedSolutions: nil,
+			solution: func() pow.Solution {
+				leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+				require.NoError(test, err)
+
+				createdAt, err := powValueTypes.NewCreatedAt(moment)
+				require.NoError(test, err)
+
+				ttl, err := powValueTypes.NewTTL(time.Hour)
+				require.NoError(test, err)
+
+				hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+				require.NoError(test, err)
+
+				challenge, err := pow.NewChallengeBuilder().
+					SetLeadingZeroBitCount(leadingZeroBitCount).
+					SetCreatedAt(createdAt).
+					SetTTL(ttl).
+					SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+					SetHash(hash).
+					SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.SerializedPayload.ToString }}:{{ .Nonce.ToString }}",
+					)).
+					Build()
+				require.NoError(test, err)
+
+				nonce, err := powValueTypes.NewNonce(big.NewInt(37))
+				require.NoError(test, err)
+
+				solution, err := pow.NewSolutionBuilder().
+					SetChallenge(challenge).
+					SetNonce(nonce).
+					Build()
+				require.NoError(test, err)
+
+				return solution
+			}(),
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/another nonce",
+			acceptedSolutions: []pow.Solution{
+				func() pow.Solution {
+					leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+					require.NoError(test, err)
+
+					createdAt, err := powValueTypes.NewCreatedAt(moment)
+					require.NoError(test, err)
+
+					ttl, err := powValueTypes.NewTTL(time.Hour)
+					require.NoError(test, err)
+
+					hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+					require.NoError(test, err)
+
+					challenge, err := pow.NewChallengeBuilder().
+						SetLeadingZeroBitCount(leadingZeroBitCount).
+						SetCreatedAt(createdAt).
+						SetTTL(ttl).
+						SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+						SetHash(hash).
+						SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+							"{{ .Challenge.SerializedPayload.ToString }}:{{ .Nonce.ToString }}",
+						)).
+						Build()
+					require.NoError(test, err)
+
+					nonce, err := powValueTypes.NewNonce(big.NewInt(37))
+					require.NoError(test, err)
+
+					solution, err := pow.NewSolutionBuilder().
+						SetChallenge(challenge).
+						SetNonce(nonce).
+						Build()
+					require.NoError(test, err)
+
+					return solution
+				}(),
+			},
+			solution: func() pow.Solution {
+				leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+				require.NoError(test, err)
+
+				createdAt, err := powValueTypes.NewCreatedAt(moment)
+				require.NoError(test, err)
+
+				ttl, err := powValueTypes.NewTTL(time.Hour)
+				require.NoError(test, err)
+
+				hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+				require.NoError(test, err)
+
+				challenge, err := pow.NewChallengeBuilder().
+					SetLeadingZeroBitCount(leadingZeroBitCount).
+					SetCreatedAt(createdAt).
+					SetTTL(ttl).
+					SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+					SetHash(hash).
+					SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.SerializedPayload.ToString }}:{{ .Nonce.ToString }}",
+					)).
+					Build()
+				require.NoError(test, err)
+
+				nonce, err := powValueTypes.NewNonce(big.NewInt(40))
+				require.NoError(test, err)
+
+				solution, err := pow.NewSolutionBuilder().
+					SetChallenge(challenge).
+					SetNonce(nonce).
+					Build()
+				require.NoError(test, err)
+
+				return solution
+			}(),
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/another challenge",
+			acceptedSolutions: []pow.Solution{
+				func() pow.Solution {
+					leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+					require.NoError(test, err)
+
+					createdAt, err := powValueTypes.NewCreatedAt(moment)
+					require.NoError(test, err)
+
+					ttl, err := powValueTypes.NewTTL(time.Hour)
+					require.NoError(test, err)
+
+					hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+					require.NoError(test, err)
+
+					challenge, err := pow.NewChallengeBuilder().
+						SetLeadingZeroBitCount(leadingZeroBitCount).
+						SetCreatedAt(createdAt).
+						SetTTL(ttl).
+						SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+						SetHash(hash).
+						SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+							"{{ .Challenge.SerializedPayload.ToString }}:{{ .Nonce.ToString }}",
+						)).
+						Build()
+					require.NoError(test, err)
+
+					nonce, err := powValueTypes.NewNonce(big.NewInt(37))
+					require.NoError(test, err)
+
+					solution, err := pow.NewSolutionBuilder().
+						SetChallenge(challenge).
+						SetNonce(nonce).
+						Build()
+					require.NoError(test, err)
+
+					return solution
+				}(),
+			},
+			solution: func() pow.Solution {
+				leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+				require.NoError(test, err)
+
+				createdAt, err := powValueTypes.NewCreatedAt(moment)
+				require.NoError(test, err)
+
+				ttl, err := powValueTypes.NewTTL(time.Hour)
+				require.NoError(test, err)
+
+				hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+				require.NoError(test, err)
+
+				challenge, err := pow.NewChallengeBuilder().
+					SetLeadingZeroBitCount(leadingZeroBitCount).
+					SetCreatedAt(createdAt).
+					SetTTL(ttl).
+					SetSerializedPayload(powValueTypes.NewSerializedPayload("another")).
+					SetHash(hash).
+					SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.SerializedPayload.ToString }}:{{ .Nonce.ToString }}",
+					)).
+					Build()
+				require.NoError(test, err)
+
+				nonce, err := powValueTypes.NewNonce(big.NewInt(37))
+				require.NoError(test, err)
+
+				solution, err := pow.NewSolutionBuilder().
+					SetChallenge(challenge).
+					SetNonce(nonce).
+					Build()
+				require.NoError(test, err)
+
+				return solution
+			}(),
+			wantErr: assert.NoError,
+		},
+		{
+			name:              "success/hash without an explicit name",
+			acceptedSolutions: nil,
+			solution: func() pow.Solution {
+				leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+				require.NoError(test, err)
+
+				createdAt, err := powValueTypes.NewCreatedAt(moment)
+				require.NoError(test, err)
+
+				ttl, err := powValueTypes.NewTTL(time.Hour)
+				require.NoError(test, err)
+
+				challenge, err := pow.NewChallengeBuilder().
+					SetLeadingZeroBitCount(leadingZeroBitCount).
+					SetCreatedAt(createdAt).
+					SetTTL(ttl).
+					SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+					SetHash(powValueTypes.NewHash(sha256.New())).
+					SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.SerializedPayload.ToString }}:{{ .Nonce.ToString }}",
+					)).
+					Build()
+				require.NoError(test, err)
+
+				nonce, err := powValueTypes.NewNonce(big.NewInt(37))
+				require.NoError(test, err)
+
+				solution, err := pow.NewSolutionBuilder().
+					SetChallenge(challenge).
+					SetNonce(nonce).
+					Build()
+				require.NoError(test, err)
+
+				return solution
+			}(),
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/replayed solution",
+			acceptedSolutions: []pow.Solution{
+				func() pow.Solution {
+					leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+					require.NoError(test, err)
+
+					createdAt, err := powValueTypes.NewCreatedAt(moment)
+					require.NoError(test, err)
+
+					ttl, err := powValueTypes.NewTTL(time.Hour)
+					require.NoError(test, err)
+
+					hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+					require.NoError(test, err)
+
+					challenge, err := pow.NewChallengeBuilder().
+						SetLeadingZeroBitCount(leadingZeroBitCount).
+						SetCreatedAt(createdAt).
+						SetTTL(ttl).
+						SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+						SetHash(hash).
+						SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+							"{{ .Challenge.SerializedPayload.ToString }}:{{ .Nonce.ToString }}",
+						)).
+						Build()
+					require.NoError(test, err)
+
+					nonce, err := powValueTypes.NewNonce(big.NewInt(37))
+					require.NoError(test, err)
+
+					solution, err := pow.NewSolutionBuilder().
+						SetChallenge(challenge).
+						SetNonce(nonce).
+						Build()
+					require.NoError(test, err)
+
+					return solution
+				}(),
+			},
+			solution: func() pow.Solution {
+				leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+				require.NoError(test, err)
+
+				createdAt, err := powValueTypes.NewCreatedAt(moment)
+				require.NoError(test, err)
+
+				ttl, err := powValueTypes.NewTTL(time.Hour)
+				require.NoError(test, err)
+
+				hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+				require.NoError(test, err)
+
+				challenge, err := pow.NewChallengeBuilder().
+					SetLeadingZeroBitCount(leadingZeroBitCount).
+					SetCreatedAt(createdAt).
+					SetTTL(ttl).
+					SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+					SetHash(hash).
+					SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.SerializedPayload.ToString }}:{{ .Nonce.ToString }}",
+					)).
+					Build()
+				require.NoError(test, err)
+
+				nonce, err := powValueTypes.NewNonce(big.NewInt(37))
+				require.NoError(test, err)
+
+				solution, err := pow.NewSolutionBuilder().
+					SetChallenge(challenge).
+					SetNonce(nonce).
+					Build()
+				require.NoError(test, err)
+
+				return solution
+			}(),
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrReplayedSolution)
+			},
+		},
+		{
+			name:              "error/expired challenge",
+			acceptedSolutions: nil,
+			solution: func() pow.Solution {
+				leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+				require.NoError(test, err)
+
+				createdAt, err := powValueTypes.NewCreatedAt(moment.Add(-2 * time.Hour))
+				require.NoError(test, err)
+
+				ttl, err := powValueTypes.NewTTL(time.Hour)
+				require.NoError(test, err)
+
+				hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+				require.NoError(test, err)
+
+				challenge, err := pow.NewChallengeBuilder().
+					SetLeadingZeroBitCount(leadingZeroBitCount).
+					SetCreatedAt(createdAt).
+					SetTTL(ttl).
+					SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+					SetHash(hash).
+					SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.SerializedPayload.ToString }}:{{ .Nonce.ToString }}",
+					)).
+					Build()
+				require.NoError(test, err)
+
+				nonce, err := powValueTypes.NewNonce(big.NewInt(37))
+				require.NoError(test, err)
+
+				solution, err := pow.NewSolutionBuilder().
+					SetChallenge(challenge).
+					SetNonce(nonce).
+					Build()
+				require.NoError(test, err)
+
+				return solution
+			}(),
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrChallengeExpired)
+			},
+		},
+		{
+			name:              "error/challenge without TTL",
+			acceptedSolutions: nil,
+			solution: func() pow.Solution {
+				leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+				require.NoError(test, err)
+
+				challenge, err := pow.NewChallengeBuilder().
+					SetLeadingZeroBitCount(leadingZeroBitCount).
+					SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+					SetHash(powValueTypes.NewHash(sha256.New())).
+					SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+						"{{ .Nonce.ToString }}",
+					)).
+					Build()
+				require.NoError(test, err)
+
+				nonce, err := powValueTypes.NewNonce(big.NewInt(37))
+				require.NoError(test, err)
+
+				solution, err := pow.NewSolutionBuilder().
+					SetChallenge(challenge).
+					SetNonce(nonce).
+					Build()
+				require.NoError(test, err)
+
+				return solution
+			}(),
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			guard := NewGuard(NewMemoryStore(MemoryStoreParams{
+				Clock: mo.Some[pow.Clock](pow.NewFixedClock(moment)),
+			}))
+			for _, solution := range data.acceptedSolutions {
+				err := guard.Accept(context.Background(), solution)
+				require.NoError(test, err)
+			}
+
+			err := guard.Accept(context.Background(), data.solution)
+
+			data.wantErr(test, err)
+		})
+	}
+}
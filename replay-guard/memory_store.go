@@ -0,0 +1,116 @@
+package powReplayGuard
+
+import (
+	"container/heap"
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/samber/mo"
+	pow "github.com/thewizardplusplus/go-pow"
+	powErrors "github.com/thewizardplusplus/go-pow/errors"
+)
+
+type MemoryStoreParams struct {
+	Clock mo.Option[pow.Clock]
+}
+
+type MemoryStore struct {
+	clock pow.Clock
+
+	mutex       sync.Mutex
+	expirations map[string]time.Time
+	queue       expirationQueue
+}
+
+func NewMemoryStore(params MemoryStoreParams) *MemoryStore {
+	return &MemoryStore{
+		clock:       params.Clock.OrElse(pow.SystemClock{}),
+		expirations: make(map[string]time.Time),
+	}
+}
+
+func (store *MemoryStore) StoreKey(
+	ctx context.Context,
+	key string,
+	expiresAt time.Time,
+) error {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	now := store.clock.Now()
+	store.evictExpiredKeys(now)
+
+	// an already expired key would be evicted at once, so it cannot protect
+	// the solution from replays and is rejected instead of being stored
+	if !expiresAt.After(now) {
+		return errors.Join(
+			errors.New("key is already expired"),
+			powErrors.ErrChallengeExpired,
+			powErrors.ErrValidationFailure,
+		)
+	}
+
+	if _, isStored := store.expirations[key]; isStored {
+		return errors.Join(
+			errors.New("key is already stored"),
+			powErrors.ErrReplayedSolution,
+			powErrors.ErrValidationFailure,
+		)
+	}
+
+	store.expirations[key] = expiresAt
+	heap.Push(&store.queue, expirationQueueItem{
+		key:       key,
+		expiresAt: expiresAt,
+	})
+
+	return nil
+}
+
+func (store *MemoryStore) Len() int {
+	store.mutex.Lock()
+	defer store.mutex.Unlock()
+
+	store.evictExpiredKeys(store.clock.Now())
+	return len(store.expirations)
+}
+
+func (store *MemoryStore) evictExpiredKeys(now time.Time) {
+	for store.queue.Len() > 0 && !store.queue[0].expiresAt.After(now) {
+		item := heap.Pop(&store.queue).(expirationQueueItem)
+		delete(store.expirations, item.key)
+	}
+}
+
+type expirationQueueItem struct {
+	key       string
+	expiresAt time.Time
+}
+
+type expirationQueue []expirationQueueItem
+
+func (queue expirationQueue) Len() int {
+	return len(queue)
+}
+
+func (queue expirationQueue) Less(i int, j int) bool {
+	return queue[i].expiresAt.Before(queue[j].expiresAt)
+}
+
+func (queue expirationQueue) Swap(i int, j int) {
+	queue[i], queue[j] = queue[j], queue[i]
+}
+
+func (queue *expirationQueue) Push(item any) {
+	*queue = append(*queue, item.(expirationQueueItem))
+}
+
+func (queue *expirationQueue) Pop() any {
+	lastIndex := len(*queue) - 1
+	item := (*queue)[lastIndex]
+	*queue = (*queue)[:lastIndex]
+
+	return item
+}
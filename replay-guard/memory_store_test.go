@@ -0,0 +1,143 @@
+package powReplayGuard
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/samber/mo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pow "github.com/thewizardplusplus/go-pow"
+	powErrors "github.com/thewizardplusplus/go-pow/errors"
+)
+
+type movableClock struct {
+	moment time.Time
+}
+
+func (clock *movableClock) Now() time.Time {
+	return clock.moment
+}
+
+func TestMemoryStore_StoreKey(test *testing.T) {
+	type args struct {
+		key       string
+		expiresAt time.Time
+	}
+
+	moment := time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC)
+	for _, data := range []struct {
+		name       string
+		storedKeys map[string]time.Time
+		args       args
+		wantLen    int
+		wantErr    assert.ErrorAssertionFunc
+	}{
+		{
+			name:       "success/new key",
+			storedKeys: map[string]time.Time{"key-1": moment.Add(time.Minute)},
+			args: args{
+				key:       "key-2",
+				expiresAt: moment.Add(time.Minute),
+			},
+			wantLen: 2,
+			wantErr: assert.NoError,
+		},
+		{
+			name:       "success/stored key is expired",
+			storedKeys: map[string]time.Time{"key-1": moment.Add(-time.Minute)},
+			args: args{
+				key:       "key-1",
+				expiresAt: moment.Add(time.Minute),
+			},
+			wantLen: 1,
+			wantErr: assert.NoError,
+		},
+		{
+			name:       "error/new key is expired",
+			storedKeys: map[string]time.Time{"key-1": moment.Add(time.Minute)},
+			args: args{
+				key:       "key-2",
+				expiresAt: moment.Add(-time.Minute),
+			},
+			wantLen: 1,
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrChallengeExpired)
+			},
+		},
+		{
+			name:       "error/new key expires right now",
+			storedKeys: map[string]time.Time{"key-1": moment.Add(time.Minute)},
+			args: args{
+				key:       "key-2",
+				expiresAt: moment,
+			},
+			wantLen: 1,
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrChallengeExpired)
+			},
+		},
+		{
+			name:       "error/key is already stored",
+			storedKeys: map[string]time.Time{"key-1": moment.Add(time.Minute)},
+			args: args{
+				key:       "key-1",
+				expiresAt: moment.Add(time.Minute),
+			},
+			wantLen: 1,
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrReplayedSolution)
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			clock := &movableClock{moment: moment.Add(-time.Hour)}
+			store := NewMemoryStore(MemoryStoreParams{
+				Clock: mo.Some[pow.Clock](clock),
+			})
+			for key, expiresAt := range data.storedKeys {
+				err := store.StoreKey(context.Background(), key, expiresAt)
+				require.NoError(test, err)
+			}
+
+			clock.moment = moment
+			err := store.StoreKey(
+				context.Background(),
+				data.args.key,
+				data.args.expiresAt,
+			)
+
+			assert.Equal(test, data.wantLen, store.Len())
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestMemoryStore_Len(test *testing.T) {
+	moment := time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC)
+	clock := &movableClock{moment: moment}
+	store := NewMemoryStore(MemoryStoreParams{
+		Clock: mo.Some[pow.Clock](clock),
+	})
+	for index, key := range []string{"key-1", "key-2", "key-3"} {
+		expiresAt := moment.Add(time.Duration(index+1) * time.Minute)
+		err := store.StoreKey(context.Background(), key, expiresAt)
+		require.NoError(test, err)
+	}
+
+	for _, data := range []struct {
+		moment  time.Time
+		wantLen int
+	}{
+		{moment: moment, wantLen: 3},
+		{moment: moment.Add(time.Minute), wantLen: 2},
+		{moment: moment.Add(90 * time.Second), wantLen: 2},
+		{moment: moment.Add(3 * time.Minute), wantLen: 0},
+	} {
+		clock.moment = data.moment
+		got := store.Len()
+
+		assert.Equal(test, data.wantLen, got)
+	}
+}
@@ -0,0 +1,13 @@
+package powReplayGuard
+
+import (
+	"context"
+	"time"
+)
+
+type Store interface {
+	// it should fail with `powErrors.ErrReplayedSolution`,
+	// if the key is already stored and isn't expired yet,
+	// and with `powErrors.ErrChallengeExpired`, if the key is already expired
+	StoreKey(ctx context.Context, key string, expiresAt time.Time) error
+}
@@ -0,0 +1,17 @@
+package powSigning
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"hash"
+)
+
+func makeChallengeSignature(
+	secret []byte,
+	writeCanonicalData func(writer hash.Hash),
+) []byte {
+	mac := hmac.New(sha256.New, secret)
+	writeCanonicalData(mac)
+
+	return mac.Sum(nil)
+}
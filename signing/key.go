@@ -0,0 +1,22 @@
+package powSigning
+
+import (
+	"errors"
+)
+
+type Key struct {
+	ID     string
+	Secret []byte
+}
+
+func (key Key) validate() error {
+	var errs []error
+	if key.ID == "" {
+		errs = append(errs, errors.New("key ID cannot be empty"))
+	}
+	if len(key.Secret) == 0 {
+		errs = append(errs, errors.New("key secret cannot be empty"))
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,55 @@
+package powSigning
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestKey_validate(test *testing.T) {
+	type fields struct {
+		ID     string
+		Secret []byte
+	}
+
+	for _, data := range []struct {
+		name    string
+		fields  fields
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			fields: fields{
+				ID:     "key-1",
+				Secret: []byte("secret"),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/empty ID",
+			fields: fields{
+				ID:     "",
+				Secret: []byte("secret"),
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/empty secret",
+			fields: fields{
+				ID:     "key-1",
+				Secret: nil,
+			},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			key := Key{
+				ID:     data.fields.ID,
+				Secret: data.fields.Secret,
+			}
+			err := key.validate()
+
+			data.wantErr(test, err)
+		})
+	}
+}
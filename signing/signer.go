@@ -0,0 +1,50 @@
+package powSigning
+
+import (
+	"errors"
+	"fmt"
+
+	pow "github.com/thewizardplusplus/go-pow"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+type Signer struct {
+	key Key
+}
+
+func NewSigner(key Key) (Signer, error) {
+	if err := key.validate(); err != nil {
+		return Signer{}, fmt.Errorf("unable to validate the key: %w", err)
+	}
+
+	signer := Signer{
+		key: key,
+	}
+	return signer, nil
+}
+
+// a zero signer isn't constructed via `NewSigner()` and has no key
+func (signer Signer) IsZero() bool {
+	return signer.key.ID == ""
+}
+
+func (signer Signer) Sign(challenge pow.Challenge) (pow.Challenge, error) {
+	// the name derived from the type of the hash instance depends
+	// on the implementation, so the signature could stop matching it
+	if !challenge.Hash().HasExplicitName() {
+		return pow.Challenge{}, errors.New("hash has no explicit name")
+	}
+
+	signature, err := powValueTypes.NewSignature(
+		signer.key.ID,
+		makeChallengeSignature(signer.key.Secret, challenge.WriteCanonicalData),
+	)
+	if err != nil {
+		return pow.Challenge{}, fmt.Errorf(
+			"unable to construct the signature: %w",
+			err,
+		)
+	}
+
+	return challenge.WithSignature(signature), nil
+}
@@ -0,0 +1,396 @@
+package powSigning
+
+import (
+	"crypto/sha256"
+	"math/big"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pow "github.com/thewizardplusplus/go-pow"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+func TestNewSigner(test *testing.T) {
+	type args struct {
+		key Key
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		want    Signer
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				key: Key{ID: "key-1", Secret: []byte("secret")},
+			},
+			want: Signer{
+				key: Key{ID: "key-1", Secret: []byte("secret")},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error",
+			args: args{
+				key: Key{ID: "", Secret: []byte("secret")},
+			},
+			want:    Signer{},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err := NewSigner(data.args.key)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestSigner_IsZero(test *testing.T) {
+	type fields struct {
+		key Key
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		want   assert.BoolAssertionFunc
+	}{
+		{
+			name: "success/is zero",
+			fields: fields{
+				key: Key{},
+			},
+			want: assert.True,
+		},
+		{
+			name: "success/isn't zero",
+			fields: fields{
+				key: Key{ID: "key-1", Secret: []byte("secret")},
+			},
+			want: assert.False,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			signer := Signer{
+				key: data.fields.key,
+			}
+			got := signer.IsZero()
+
+			data.want(test, got)
+		})
+	}
+}
+
+func TestSigner_Sign(test *testing.T) {
+	type fields struct {
+		key Key
+	}
+	type args struct {
+		challenge pow.Challenge
+	}
+
+	for _, data := range []struct {
+		name          string
+		fields        fields
+		args          args
+		wantKeyID     string
+		wantSignature string
+		wantErr       assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/all parameters",
+			fields: fields{
+				key: Key{ID: "key-1", Secret: []byte("secret")},
+			},
+			args: args{
+				challenge: func() pow.Challenge {
+					leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(23)
+					require.NoError(test, err)
+
+					createdAt, err := powValueTypes.NewCreatedAt(
+						time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC),
+					)
+					require.NoError(test, err)
+
+					ttl, err := powValueTypes.NewTTL(100 * 365 * 24 * time.Hour)
+					require.NoError(test, err)
+
+					hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+					require.NoError(test, err)
+
+					challenge, err := pow.NewChallengeBuilder().
+						SetLeadingZeroBitCount(leadingZeroBitCount).
+						SetCreatedAt(createdAt).
+						SetTTL(ttl).
+						SetResource(powValueTypes.NewResource(&url.URL{
+							Scheme: "https",
+							Host:   "example.com",
+							Path:   "/",
+						})).
+						SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+						SetHash(hash).
+						SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+							"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+								":{{ .Challenge.SerializedPayload.ToString }}" +
+								":{{ .Nonce.ToString }}",
+						)).
+						Build()
+					require.NoError(test, err)
+
+					return challenge
+				}(),
+			},
+			wantKeyID: "key-1",
+			wantSignature: "7196315d9abcfafa9e6cc99bcb1b5b4e" +
+				"71631e26ca1912784d7f768dc50b8874",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/required parameters only",
+			fields: fields{
+				key: Key{ID: "key-1", Secret: []byte("secret")},
+			},
+			args: args{
+				challenge: func() pow.Challenge {
+					leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(23)
+					require.NoError(test, err)
+
+					hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+					require.NoError(test, err)
+
+					challenge, err := pow.NewChallengeBuilder().
+						SetLeadingZeroBitCount(leadingZeroBitCount).
+						SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+						SetHash(hash).
+						SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+							"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+								":{{ .Challenge.SerializedPayload.ToString }}" +
+								":{{ .Nonce.ToString }}",
+						)).
+						Build()
+					require.NoError(test, err)
+
+					return challenge
+				}(),
+			},
+			wantKeyID: "key-1",
+			wantSignature: "f66029b0e2c00aea7fdfc66cb10ee2b8" +
+				"708ed59cfa304e38ff646a0efebe95d6",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/arbitrary target",
+			fields: fields{
+				key: Key{ID: "key-1", Secret: []byte("secret")},
+			},
+			args: args{
+				challenge: func() pow.Challenge {
+					target, err := powValueTypes.NewTarget(big.NewInt(1536))
+					require.NoError(test, err)
+
+					hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+					require.NoError(test, err)
+
+					challenge, err := pow.NewChallengeBuilder().
+						SetTarget(target).
+						SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+						SetHash(hash).
+						SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+							"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+								":{{ .Challenge.SerializedPayload.ToString }}" +
+								":{{ .Nonce.ToString }}",
+						)).
+						Build()
+					require.NoError(test, err)
+
+					return challenge
+				}(),
+			},
+			wantKeyID: "key-1",
+			wantSignature: "4d6f766642955e99b35be406c0082a48" +
+				"9c9b443e2e9116e97ce9eac94b1afc26",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/binary hash data layout",
+			fields: fields{
+				key: Key{ID: "key-1", Secret: []byte("secret")},
+			},
+			args: args{
+				challenge: func() pow.Challenge {
+					leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+					require.NoError(test, err)
+
+					hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+					require.NoError(test, err)
+
+					challenge, err := pow.NewChallengeBuilder().
+						SetLeadingZeroBitCount(leadingZeroBitCount).
+						SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+						SetHash(hash).
+						SetHashDataLayout(powValueTypes.NewBinaryHashDataLayout(
+							powValueTypes.MustParseBinaryHashDataLayout(
+								"leading-zero-bit-count,payload,nonce:8",
+							),
+						)).
+						Build()
+					require.NoError(test, err)
+
+					return challenge
+				}(),
+			},
+			wantKeyID: "key-1",
+			wantSignature: "6968ba0e60b9530548f940b83e7452f8" +
+				"5f7019a3ece7c422fa9d249cada197d8",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/nonce encoding",
+			fields: fields{
+				key: Key{ID: "key-1", Secret: []byte("secret")},
+			},
+			args: args{
+				challenge: func() pow.Challenge {
+					leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+					require.NoError(test, err)
+
+					hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+					require.NoError(test, err)
+
+					nonceEncoding, err := powValueTypes.NewFixedWidthNonceEncoding(8)
+					require.NoError(test, err)
+
+					challenge, err := pow.NewChallengeBuilder().
+						SetLeadingZeroBitCount(leadingZeroBitCount).
+						SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+						SetHash(hash).
+						SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+							"{{ .Challenge.SerializedPayload.ToString }}:{{ .Nonce.ToString }}",
+						)).
+						SetNonceEncoding(nonceEncoding).
+						Build()
+					require.NoError(test, err)
+
+					return challenge
+				}(),
+			},
+			wantKeyID: "key-1",
+			wantSignature: "bd25f3a78a5b63e893b8af23e086b02a" +
+				"619cd01f10ccea3c62a5e2a908717f64",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/required hash data fields",
+			fields: fields{
+				key: Key{ID: "key-1", Secret: []byte("secret")},
+			},
+			args: args{
+				challenge: func() pow.Challenge {
+					leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+					require.NoError(test, err)
+
+					hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+					require.NoError(test, err)
+
+					challenge, err := pow.NewChallengeBuilder().
+						SetLeadingZeroBitCount(leadingZeroBitCount).
+						SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+						SetHash(hash).
+						SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+							"{{ .Challenge.SerializedPayload.ToString }}:{{ .Nonce.ToString }}",
+						)).
+						SetRequiredHashDataFields([]powValueTypes.HashDataField{
+							powValueTypes.HashDataFieldNonce,
+							powValueTypes.HashDataFieldPayload,
+						}).
+						Build()
+					require.NoError(test, err)
+
+					return challenge
+				}(),
+			},
+			wantKeyID: "key-1",
+			wantSignature: "774e2f69864398a885059cd6a6b5ebf4" +
+				"00abbfa64f2b1bd942454037480dd66f",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/hash without an explicit name",
+			fields: fields{
+				key: Key{ID: "key-1", Secret: []byte("secret")},
+			},
+			args: args{
+				challenge: func() pow.Challenge {
+					leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+					require.NoError(test, err)
+
+					challenge, err := pow.NewChallengeBuilder().
+						SetLeadingZeroBitCount(leadingZeroBitCount).
+						SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+						SetHash(powValueTypes.NewHash(sha256.New())).
+						SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+							"{{ .Challenge.SerializedPayload.ToString }}:{{ .Nonce.ToString }}",
+						)).
+						Build()
+					require.NoError(test, err)
+
+					return challenge
+				}(),
+			},
+			wantKeyID:     "",
+			wantSignature: "",
+			wantErr:       assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			signer := Signer{
+				key: data.fields.key,
+			}
+			got, err := signer.Sign(data.args.challenge)
+
+			data.wantErr(test, err)
+			if err == nil {
+				gotSignature := got.Signature().MustGet()
+				assert.Equal(test, data.wantKeyID, gotSignature.KeyID())
+				assert.Equal(test, data.wantSignature, gotSignature.ToString())
+				assert.Equal(
+					test,
+					data.args.challenge.LeadingZeroBitCount(),
+					got.LeadingZeroBitCount(),
+				)
+				assert.Equal(
+					test,
+					data.args.challenge.ArbitraryTarget(),
+					got.ArbitraryTarget(),
+				)
+				assert.Equal(test, data.args.challenge.CreatedAt(), got.CreatedAt())
+				assert.Equal(test, data.args.challenge.TTL(), got.TTL())
+				assert.Equal(test, data.args.challenge.Resource(), got.Resource())
+				assert.Equal(
+					test,
+					data.args.challenge.HashDataLayout().ToString(),
+					got.HashDataLayout().ToString(),
+				)
+				assert.Equal(
+					test,
+					data.args.challenge.HashDataLayout().Kind(),
+					got.HashDataLayout().Kind(),
+				)
+				assert.Equal(
+					test,
+					data.args.challenge.NonceEncoding(),
+					got.NonceEncoding(),
+				)
+			} else {
+				assert.Equal(test, pow.Challenge{}, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package powSigning
+
+import (
+	"crypto/hmac"
+	"errors"
+	"fmt"
+	"hash"
+
+	"github.com/samber/mo"
+	pow "github.com/thewizardplusplus/go-pow"
+	powErrors "github.com/thewizardplusplus/go-pow/errors"
+	powHooks "github.com/thewizardplusplus/go-pow/internal/hooks"
+	powRawChallenge "github.com/thewizardplusplus/go-pow/internal/raw-challenge"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+type Verifier struct {
+	secretsByKeyID map[string][]byte
+}
+
+func NewVerifier(keys ...Key) (Verifier, error) {
+	// several keys allow to rotate them: a new key is used for signing,
+	// while the old ones are still accepted for verification
+	if len(keys) == 0 {
+		return Verifier{}, errors.New("at least one key is required")
+	}
+
+	secretsByKeyID := make(map[string][]byte, len(keys))
+	for _, key := range keys {
+		if err := key.validate(); err != nil {
+			return Verifier{}, fmt.Errorf("unable to validate the key: %w", err)
+		}
+
+		if _, isDuplicated := secretsByKeyID[key.ID]; isDuplicated {
+			return Verifier{}, fmt.Errorf("key %q is duplicated", key.ID)
+		}
+
+		secretsByKeyID[key.ID] = key.Secret
+	}
+
+	verifier := Verifier{
+		secretsByKeyID: secretsByKeyID,
+	}
+	return verifier, nil
+}
+
+// a zero verifier isn't constructed via `NewVerifier()` and has no keys
+func (verifier Verifier) IsZero() bool {
+	return len(verifier.secretsByKeyID) == 0
+}
+
+func (verifier Verifier) VerifyChallenge(challenge pow.Challenge) error {
+	return verifier.verifySignature(
+		challenge.Signature(),
+		challenge.WriteCanonicalData,
+	)
+}
+
+func (verifier Verifier) VerifySolution(
+	solution pow.Solution,
+	params pow.VerifyParams,
+) error {
+	if err := verifier.VerifyChallenge(solution.Challenge()); err != nil {
+		return fmt.Errorf("unable to verify the challenge: %w", err)
+	}
+
+	if err := solution.VerifyWithParams(params); err != nil {
+		return fmt.Errorf("unable to verify the solution: %w", err)
+	}
+
+	return nil
+}
+
+// the signature is checked before the challenge is built, so the hash data
+// layout of a forged challenge is never parsed or executed; the solution
+// still has to be verified after that
+func (verifier Verifier) UnmarshalSolutionJSON(
+	data []byte,
+	hashRegistry *powValueTypes.HashRegistry,
+) (pow.Solution, error) {
+	rawChallenge, err := powHooks.UnmarshalRawSolutionChallenge(data)
+	if err != nil {
+		return pow.Solution{}, fmt.Errorf(
+			"unable to unmarshal the raw challenge: %w",
+			err,
+		)
+	}
+
+	if err := verifier.verifyRawChallenge(rawChallenge); err != nil {
+		return pow.Solution{}, fmt.Errorf(
+			"unable to verify the challenge: %w",
+			err,
+		)
+	}
+
+	solution, err := pow.UnmarshalSolutionJSON(data, hashRegistry)
+	if err != nil {
+		return pow.Solution{}, fmt.Errorf(
+			"unable to unmarshal the solution: %w",
+			err,
+		)
+	}
+
+	return solution, nil
+}
+
+func (verifier Verifier) verifyRawChallenge(
+	challenge powRawChallenge.RawChallenge,
+) error {
+	signature := mo.None[powValueTypes.Signature]()
+	if rawSignature, isPresent := challenge.Signature.Get(); isPresent {
+		parsedSignature, err :=
+			powValueTypes.ParseSignature(rawSignature.KeyID, rawSignature.Value)
+		if err != nil {
+			return errors.Join(
+				fmt.Errorf("unable to parse the signature: %w", err),
+				powErrors.ErrInvalidSignature,
+				powErrors.ErrValidationFailure,
+			)
+		}
+
+		signature = mo.Some(parsedSignature)
+	}
+
+	return verifier.verifySignature(signature, func(writer hash.Hash) {
+		powRawChallenge.WriteCanonicalData(writer, challenge)
+	})
+}
+
+func (verifier Verifier) verifySignature(
+	challengeSignature mo.Option[powValueTypes.Signature],
+	writeCanonicalData func(writer hash.Hash),
+) error {
+	signature, isPresent := challengeSignature.Get()
+	if !isPresent {
+		return errors.Join(
+			errors.New("challenge signature is missing"),
+			powErrors.ErrInvalidSignature,
+			powErrors.ErrValidationFailure,
+		)
+	}
+
+	secret, isKnown := verifier.secretsByKeyID[signature.KeyID()]
+	if !isKnown {
+		return errors.Join(
+			fmt.Errorf("signature key %q is unknown", signature.KeyID()),
+			powErrors.ErrInvalidSignature,
+			powErrors.ErrValidationFailure,
+		)
+	}
+
+	expectedSignature := makeChallengeSignature(secret, writeCanonicalData)
+	if !hmac.Equal(signature.ToBytes(), expectedSignature) {
+		return errors.Join(
+			errors.New("challenge signature doesn't match the expected one"),
+			powErrors.ErrInvalidSignature,
+			powErrors.ErrValidationFailure,
+		)
+	}
+
+	return nil
+}
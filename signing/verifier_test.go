@@ -0,0 +1,638 @@
+package powSigning
+
+import (
+	"context"
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	pow "github.com/thewizardplusplus/go-pow"
+	powErrors "github.com/thewizardplusplus/go-pow/errors"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+func TestNewVerifier(test *testing.T) {
+	type args struct {
+		keys []Key
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		want    Verifier
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				keys: []Key{
+					{ID: "key-1", Secret: []byte("secret-1")},
+					{ID: "key-2", Secret: []byte("secret-2")},
+				},
+			},
+			want: Verifier{
+				secretsByKeyID: map[string][]byte{
+					"key-1": []byte("secret-1"),
+					"key-2": []byte("secret-2"),
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/no keys",
+			args: args{
+				keys: nil,
+			},
+			want:    Verifier{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/invalid key",
+			args: args{
+				keys: []Key{
+					{ID: "key-1", Secret: []byte("secret-1")},
+					{ID: "key-2", Secret: nil},
+				},
+			},
+			want:    Verifier{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/duplicated key",
+			args: args{
+				keys: []Key{
+					{ID: "key-1", Secret: []byte("secret-1")},
+					{ID: "key-1", Secret: []byte("secret-2")},
+				},
+			},
+			want:    Verifier{},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err := NewVerifier(data.args.keys...)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestVerifier_IsZero(test *testing.T) {
+	type fields struct {
+		secretsByKeyID map[string][]byte
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		want   assert.BoolAssertionFunc
+	}{
+		{
+			name: "success/is zero",
+			fields: fields{
+				secretsByKeyID: nil,
+			},
+			want: assert.True,
+		},
+		{
+			name: "success/isn't zero",
+			fields: fields{
+				secretsByKeyID: map[string][]byte{
+					"key-1": []byte("secret-1"),
+				},
+			},
+			want: assert.False,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			verifier := Verifier{
+				secretsByKeyID: data.fields.secretsByKeyID,
+			}
+			got := verifier.IsZero()
+
+			data.want(test, got)
+		})
+	}
+}
+
+func TestVerifier_VerifyChallenge(test *testing.T) {
+	type fields struct {
+		secretsByKeyID map[string][]byte
+	}
+	type args struct {
+		challenge pow.Challenge
+	}
+
+	for _, data := range []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/current key",
+			fields: fields{
+				secretsByKeyID: map[string][]byte{
+					"key-1": []byte("secret-1"),
+					"key-2": []byte("secret-2"),
+				},
+			},
+			args: args{
+				challenge: func() pow.Challenge {
+					leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(23)
+					require.NoError(test, err)
+
+					hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+					require.NoError(test, err)
+
+					challenge, err := pow.NewChallengeBuilder().
+						SetLeadingZeroBitCount(leadingZeroBitCount).
+						SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+						SetHash(hash).
+						SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+							"{{ .Challenge.SerializedPayload.ToString }}:{{ .Nonce.ToString }}",
+						)).
+						Build()
+					require.NoError(test, err)
+
+					signer, err := NewSigner(Key{ID: "key-2", Secret: []byte("secret-2")})
+					require.NoError(test, err)
+
+					signedChallenge, err := signer.Sign(challenge)
+					require.NoError(test, err)
+
+					return signedChallenge
+				}(),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/previous key",
+			fields: fields{
+				secretsByKeyID: map[string][]byte{
+					"key-1": []byte("secret-1"),
+					"key-2": []byte("secret-2"),
+				},
+			},
+			args: args{
+				challenge: func() pow.Challenge {
+					leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(23)
+					require.NoError(test, err)
+
+					hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+					require.NoError(test, err)
+
+					challenge, err := pow.NewChallengeBuilder().
+						SetLeadingZeroBitCount(leadingZeroBitCount).
+						SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+						SetHash(hash).
+						SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+							"{{ .Challenge.SerializedPayload.ToString }}:{{ .Nonce.ToString }}",
+						)).
+						Build()
+					require.NoError(test, err)
+
+					signer, err := NewSigner(Key{ID: "key-1", Secret: []byte("secret-1")})
+					require.NoError(test, err)
+
+					signedChallenge, err := signer.Sign(challenge)
+					require.NoError(test, err)
+
+					return signedChallenge
+				}(),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/signature is missing",
+			fields: fields{
+				secretsByKeyID: map[string][]byte{
+					"key-1": []byte("secret-1"),
+				},
+			},
+			args: args{
+				challenge: func() pow.Challenge {
+					leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(23)
+					require.NoError(test, err)
+
+					hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+					require.NoError(test, err)
+
+					challenge, err := pow.NewChallengeBuilder().
+						SetLeadingZeroBitCount(leadingZeroBitCount).
+						SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+						SetHash(hash).
+						SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+							"{{ .Challenge.SerializedPayload.ToString }}:{{ .Nonce.ToString }}",
+						)).
+						Build()
+					require.NoError(test, err)
+
+					return challenge
+				}(),
+			},
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrInvalidSignature)
+			},
+		},
+		{
+			name: "error/signature key is unknown",
+			fields: fields{
+				secretsByKeyID: map[string][]byte{
+					"key-1": []byte("secret-1"),
+				},
+			},
+			args: args{
+				challenge: func() pow.Challenge {
+					leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(23)
+					require.NoError(test, err)
+
+					hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+					require.NoError(test, err)
+
+					challenge, err := pow.NewChallengeBuilder().
+						SetLeadingZeroBitCount(leadingZeroBitCount).
+						SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+						SetHash(hash).
+						SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+							"{{ .Challenge.SerializedPayload.ToString }}:{{ .Nonce.ToString }}",
+						)).
+						Build()
+					require.NoError(test, err)
+
+					signer, err := NewSigner(Key{ID: "key-2", Secret: []byte("secret-2")})
+					require.NoError(test, err)
+
+					signedChallenge, err := signer.Sign(challenge)
+					require.NoError(test, err)
+
+					return signedChallenge
+				}(),
+			},
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrInvalidSignature)
+			},
+		},
+		{
+			name: "error/signature is wrong",
+			fields: fields{
+				secretsByKeyID: map[string][]byte{
+					"key-1": []byte("secret-1"),
+				},
+			},
+			args: args{
+				challenge: func() pow.Challenge {
+					leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(23)
+					require.NoError(test, err)
+
+					hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+					require.NoError(test, err)
+
+					challenge, err := pow.NewChallengeBuilder().
+						SetLeadingZeroBitCount(leadingZeroBitCount).
+						SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+						SetHash(hash).
+						SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+							"{{ .Challenge.SerializedPayload.ToString }}:{{ .Nonce.ToString }}",
+						)).
+						Build()
+					require.NoError(test, err)
+
+					signer, err := NewSigner(Key{ID: "key-1", Secret: []byte("secret-1")})
+					require.NoError(test, err)
+
+					signedChallenge, err := signer.Sign(challenge)
+					require.NoError(test, err)
+
+					// make the challenge easier, but keep the signature
+					easierLeadingZeroBitCount, err :=
+						powValueTypes.NewLeadingZeroBitCount(0)
+					require.NoError(test, err)
+
+					forgedChallenge, err := pow.NewChallengeBuilder().
+						SetLeadingZeroBitCount(easierLeadingZeroBitCount).
+						SetSerializedPayload(signedChallenge.SerializedPayload()).
+						SetHash(signedChallenge.Hash()).
+						SetHashDataLayout(signedChallenge.HashDataLayout()).
+						SetSignature(signedChallenge.Signature().MustGet()).
+						Build()
+					require.NoError(test, err)
+
+					return forgedChallenge
+				}(),
+			},
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrInvalidSignature)
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			verifier := Verifier{
+				secretsByKeyID: data.fields.secretsByKeyID,
+			}
+			err := verifier.VerifyChallenge(data.args.challenge)
+
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestVerifier_VerifySolution(test *testing.T) {
+	type fields struct {
+		secretsByKeyID map[string][]byte
+	}
+	type args struct {
+		solution pow.Solution
+		params   pow.VerifyParams
+	}
+
+	for _, data := range []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			fields: fields{
+				secretsByKeyID: map[string][]byte{
+					"key-1": []byte("secret-1"),
+				},
+			},
+			args: args{
+				solution: func() pow.Solution {
+					leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+					require.NoError(test, err)
+
+					hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+					require.NoError(test, err)
+
+					challenge, err := pow.NewChallengeBuilder().
+						SetLeadingZeroBitCount(leadingZeroBitCount).
+						SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+						SetHash(hash).
+						SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+							"{{ .Challenge.SerializedPayload.ToString }}:{{ .Nonce.ToString }}",
+						)).
+						Build()
+					require.NoError(test, err)
+
+					signer, err := NewSigner(Key{ID: "key-1", Secret: []byte("secret-1")})
+					require.NoError(test, err)
+
+					signedChallenge, err := signer.Sign(challenge)
+					require.NoError(test, err)
+
+					solution, err :=
+						signedChallenge.Solve(context.Background(), pow.SolveParams{})
+					require.NoError(test, err)
+
+					return solution
+				}(),
+				params: pow.VerifyParams{},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/unable to verify the challenge",
+			fields: fields{
+				secretsByKeyID: map[string][]byte{
+					"key-1": []byte("secret-1"),
+				},
+			},
+			args: args{
+				solution: func() pow.Solution {
+					leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+					require.NoError(test, err)
+
+					hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+					require.NoError(test, err)
+
+					challenge, err := pow.NewChallengeBuilder().
+						SetLeadingZeroBitCount(leadingZeroBitCount).
+						SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+						SetHash(hash).
+						SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+							"{{ .Challenge.SerializedPayload.ToString }}:{{ .Nonce.ToString }}",
+						)).
+						Build()
+					require.NoError(test, err)
+
+					solution, err :=
+						challenge.Solve(context.Background(), pow.SolveParams{})
+					require.NoError(test, err)
+
+					return solution
+				}(),
+				params: pow.VerifyParams{},
+			},
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrInvalidSignature)
+			},
+		},
+		{
+			name: "error/unable to verify the solution",
+			fields: fields{
+				secretsByKeyID: map[string][]byte{
+					"key-1": []byte("secret-1"),
+				},
+			},
+			args: args{
+				solution: func() pow.Solution {
+					leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(23)
+					require.NoError(test, err)
+
+					hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+					require.NoError(test, err)
+
+					challenge, err := pow.NewChallengeBuilder().
+						SetLeadingZeroBitCount(leadingZeroBitCount).
+						SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+						SetHash(hash).
+						SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+							"{{ .Challenge.SerializedPayload.ToString }}:{{ .Nonce.ToString }}",
+						)).
+						Build()
+					require.NoError(test, err)
+
+					signer, err := NewSigner(Key{ID: "key-1", Secret: []byte("secret-1")})
+					require.NoError(test, err)
+
+					signedChallenge, err := signer.Sign(challenge)
+					require.NoError(test, err)
+
+					nonce, err := powValueTypes.ParseNonce("23")
+					require.NoError(test, err)
+
+					solution, err := pow.NewSolutionBuilder().
+						SetChallenge(signedChallenge).
+						SetNonce(nonce).
+						Build()
+					require.NoError(test, err)
+
+					return solution
+				}(),
+				params: pow.VerifyParams{},
+			},
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrValidationFailure)
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			verifier := Verifier{
+				secretsByKeyID: data.fields.secretsByKeyID,
+			}
+			err := verifier.VerifySolution(data.args.solution, data.args.params)
+
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestVerifier_UnmarshalSolutionJSON(test *testing.T) {
+	type fields struct {
+		secretsByKeyID map[string][]byte
+	}
+	type args struct {
+		data         []byte
+		hashRegistry *powValueTypes.HashRegistry
+	}
+
+	for _, data := range []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			fields: fields{
+				secretsByKeyID: map[string][]byte{
+					"key-1": []byte("secret-1"),
+				},
+			},
+			args: args{
+				data: func() []byte {
+					leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+					require.NoError(test, err)
+
+					hash, err := powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+					require.NoError(test, err)
+
+					challenge, err := pow.NewChallengeBuilder().
+						SetLeadingZeroBitCount(leadingZeroBitCount).
+						SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+						SetHash(hash).
+						SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+							"{{ .Challenge.SerializedPayload.ToString }}:{{ .Nonce.ToString }}",
+						)).
+						Build()
+					require.NoError(test, err)
+
+					signer, err := NewSigner(Key{ID: "key-1", Secret: []byte("secret-1")})
+					require.NoError(test, err)
+
+					signedChallenge, err := signer.Sign(challenge)
+					require.NoError(test, err)
+
+					solution, err :=
+						signedChallenge.Solve(context.Background(), pow.SolveParams{})
+					require.NoError(test, err)
+
+					data, err := solution.MarshalJSON()
+					require.NoError(test, err)
+
+					return data
+				}(),
+				hashRegistry: powValueTypes.DefaultHashRegistry,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/invalid JSON",
+			fields: fields{
+				secretsByKeyID: map[string][]byte{
+					"key-1": []byte("secret-1"),
+				},
+			},
+			args: args{
+				data:         []byte("invalid"),
+				hashRegistry: powValueTypes.DefaultHashRegistry,
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/forged challenge",
+			fields: fields{
+				secretsByKeyID: map[string][]byte{
+					"key-1": []byte("secret-1"),
+				},
+			},
+			args: args{
+				// the layout is invalid, so the error would be different,
+				// if it were parsed before the signature check
+				data: []byte(`{
+					"challenge": {
+						"leading_zero_bit_count": 0,
+						"serialized_payload": "dummy",
+						"hash_name": "SHA-256",
+						"hash_data_layout": "{{ .Nonce",
+						"hash_data_layout_kind": "text",
+						"signature": {
+							"key_id": "key-1",
+							"value": "00"
+						}
+					},
+					"nonce": "0"
+				}`),
+				hashRegistry: powValueTypes.DefaultHashRegistry,
+			},
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrInvalidSignature)
+			},
+		},
+		{
+			name: "error/malformed signature",
+			fields: fields{
+				secretsByKeyID: map[string][]byte{
+					"key-1": []byte("secret-1"),
+				},
+			},
+			args: args{
+				data: []byte(`{
+					"challenge": {
+						"leading_zero_bit_count": 0,
+						"serialized_payload": "dummy",
+						"hash_name": "SHA-256",
+						"hash_data_layout": "{{ .Nonce.ToString }}",
+						"hash_data_layout_kind": "text",
+						"signature": {
+							"key_id": "key-1",
+							"value": "not hex"
+						}
+					},
+					"nonce": "0"
+				}`),
+				hashRegistry: powValueTypes.DefaultHashRegistry,
+			},
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrInvalidSignature)
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			verifier := Verifier{
+				secretsByKeyID: data.fields.secretsByKeyID,
+			}
+			_, err := verifier.UnmarshalSolutionJSON(
+				data.args.data,
+				data.args.hashRegistry,
+			)
+
+			data.wantErr(test, err)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/samber/mo"
 	powErrors "github.com/thewizardplusplus/go-pow/errors"
@@ -29,10 +30,9 @@ func (entity Solution) HashSum() mo.Option[powValueTypes.HashSum] {
 }
 
 func (entity Solution) Verify() error {
-	hashData, err := entity.challenge.hashDataLayout.Execute(ChallengeHashData{
-		Challenge: entity.challenge,
-		Nonce:     entity.nonce,
-	})
+	hashData, err := entity.challenge.hashDataLayout.Execute(
+		entity.challenge.newHashData(entity.nonce),
+	)
 	if err != nil {
 		return fmt.Errorf("unable to execute the hash data layout: %w", err)
 	}
@@ -47,12 +47,11 @@ func (entity Solution) Verify() error {
 		)
 	}
 
-	targetBitIndex, err := entity.challenge.TargetBitIndex()
+	target, err := entity.challenge.Target()
 	if err != nil {
-		return fmt.Errorf("unable to get the target bit index: %w", err)
+		return fmt.Errorf("unable to get the target: %w", err)
 	}
 
-	target := makeTarget(targetBitIndex)
 	if !isHashSumFitTarget(hashSum, target) {
 		return errors.Join(
 			errors.New("hash sum doesn't fit the target"),
@@ -62,3 +61,36 @@ func (entity Solution) Verify() error {
 
 	return nil
 }
+
+type VerifyParams struct {
+	Clock              mo.Option[Clock]
+	ClockSkewTolerance mo.Option[time.Duration]
+	ExpectedResource   mo.Option[powValueTypes.Resource]
+}
+
+func (entity Solution) VerifyWithParams(params VerifyParams) error {
+	if !entity.challenge.IsAliveWithParams(LivenessParams{
+		Clock:              params.Clock,
+		ClockSkewTolerance: params.ClockSkewTolerance,
+	}) {
+		return errors.Join(
+			errors.New("challenge is expired"),
+			powErrors.ErrChallengeExpired,
+			powErrors.ErrValidationFailure,
+		)
+	}
+
+	if expectedResource, isPresent := params.ExpectedResource.Get(); isPresent {
+		resource, isResourcePresent := entity.challenge.resource.Get()
+		if !isResourcePresent ||
+			resource.ToString() != expectedResource.ToString() {
+			return errors.Join(
+				errors.New("resource doesn't match the expected one"),
+				powErrors.ErrResourceMismatch,
+				powErrors.ErrValidationFailure,
+			)
+		}
+	}
+
+	return entity.Verify()
+}
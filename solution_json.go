@@ -0,0 +1,134 @@
+package pow
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/samber/mo"
+	powRawChallenge "github.com/thewizardplusplus/go-pow/internal/raw-challenge"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+type solutionJSONModel struct {
+	Challenge powRawChallenge.RawChallenge `json:"challenge"`
+	Nonce     string                       `json:"nonce"`
+	HashSum   mo.Option[string]            `json:"hash_sum"`
+}
+
+func UnmarshalSolutionJSON(
+	data []byte,
+	hashRegistry *powValueTypes.HashRegistry,
+) (Solution, error) {
+	var model solutionJSONModel
+	if err := json.Unmarshal(data, &model); err != nil {
+		return Solution{}, fmt.Errorf(
+			"unable to unmarshal the solution model: %w",
+			err,
+		)
+	}
+
+	entity, err := model.toEntity(hashRegistry)
+	if err != nil {
+		return Solution{}, fmt.Errorf(
+			"unable to convert the solution model: %w",
+			err,
+		)
+	}
+
+	return entity, nil
+}
+
+// only the challenge of the solution is unmarshalled, and its fields
+// are left raw, so the signature can be checked before the challenge is built
+func unmarshalRawSolutionChallenge(
+	data []byte,
+) (powRawChallenge.RawChallenge, error) {
+	var model solutionJSONModel
+	if err := json.Unmarshal(data, &model); err != nil {
+		return powRawChallenge.RawChallenge{}, fmt.Errorf(
+			"unable to unmarshal the solution model: %w",
+			err,
+		)
+	}
+
+	rawChallenge := model.Challenge
+	rawChallenge.HashDataLayoutKind =
+		string(hashDataLayoutKindOrDefault(rawChallenge.HashDataLayoutKind))
+	return rawChallenge, nil
+}
+
+func (entity Solution) MarshalJSON() ([]byte, error) {
+	challengeModel, err := newChallengeJSONModel(entity.challenge)
+	if err != nil {
+		return nil, fmt.Errorf("unable to make the challenge model: %w", err)
+	}
+
+	model := solutionJSONModel{
+		Challenge: challengeModel,
+		Nonce:     powValueTypes.DefaultNonceEncoding.Encode(entity.nonce),
+		HashSum:   mapOption(entity.hashSum, powValueTypes.HashSum.ToString),
+	}
+
+	data, err := json.Marshal(model)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal the solution model: %w", err)
+	}
+
+	return data, nil
+}
+
+func (entity *Solution) UnmarshalJSON(data []byte) error {
+	solution, err :=
+		UnmarshalSolutionJSON(data, powValueTypes.DefaultHashRegistry)
+	if err != nil {
+		return err
+	}
+
+	*entity = solution
+	return nil
+}
+
+func (model solutionJSONModel) toEntity(
+	hashRegistry *powValueTypes.HashRegistry,
+) (Solution, error) {
+	var errs []error
+	builder := NewSolutionBuilder()
+
+	challenge, err := newChallengeFromRaw(model.Challenge, hashRegistry)
+	if err != nil {
+		errs = append(
+			errs,
+			fmt.Errorf("unable to convert the challenge model: %w", err),
+		)
+	} else {
+		builder.SetChallenge(challenge)
+	}
+
+	nonce, err := powValueTypes.ParseNonce(model.Nonce)
+	if err != nil {
+		errs = append(errs, fmt.Errorf("unable to parse the nonce: %w", err))
+	} else {
+		builder.SetNonce(nonce)
+	}
+
+	if rawHashSum, isPresent := model.HashSum.Get(); isPresent {
+		hashSum, err := powValueTypes.ParseHashSum(rawHashSum)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("unable to parse the hash sum: %w", err))
+		} else {
+			builder.SetHashSum(hashSum)
+		}
+	}
+
+	if len(errs) > 0 {
+		return Solution{}, errors.Join(errs...)
+	}
+
+	solution, err := builder.Build()
+	if err != nil {
+		return Solution{}, fmt.Errorf("unable to build the solution: %w", err)
+	}
+
+	return solution, nil
+}
@@ -0,0 +1,466 @@
+package pow
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/samber/mo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	powValueTypes "github.com/thewizardplusplus/go-pow/value-types"
+)
+
+func TestSolution_MarshalJSON(test *testing.T) {
+	type fields struct {
+		challenge Challenge
+		nonce     powValueTypes.Nonce
+		hashSum   mo.Option[powValueTypes.HashSum]
+	}
+
+	for _, data := range []struct {
+		name    string
+		fields  fields
+		want    string
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/hash sum is present",
+			fields: fields{
+				challenge: Challenge{
+					leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(5)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+					hash: func() powValueTypes.Hash {
+						value, err :=
+							powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+						require.NoError(test, err)
+
+						return value
+					}(),
+					hashDataLayout: powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+							":{{ .Challenge.SerializedPayload.ToString }}" +
+							":{{ .Nonce.ToString }}",
+					),
+				},
+				nonce: func() powValueTypes.Nonce {
+					value, err := powValueTypes.NewNonce(big.NewInt(37))
+					require.NoError(test, err)
+
+					return value
+				}(),
+				hashSum: mo.Some(powValueTypes.NewHashSum([]byte{
+					0x00, 0x5d, 0x37, 0x2c, 0x56, 0xe6, 0xc6, 0xb5,
+					0x2a, 0xd4, 0xa8, 0x32, 0x56, 0x54, 0x69, 0x2e,
+					0xc9, 0xaa, 0x3a, 0xf5, 0xf7, 0x30, 0x21, 0x74,
+					0x8b, 0xc3, 0xfd, 0xb1, 0x24, 0xae, 0x9b, 0x20,
+				})),
+			},
+			want: `{
+				"challenge": {
+					"leading_zero_bit_count": 5,
+					"target": null,
+					"created_at": null,
+					"ttl": null,
+					"resource": null,
+					"serialized_payload": "dummy",
+					"hash_name": "SHA-256",
+					"hash_data_layout": "{{.Challenge.LeadingZeroBitCount.ToInt}}` +
+				`:{{.Challenge.SerializedPayload.ToString}}` +
+				`:{{.Nonce.ToString}}",
+					"hash_data_layout_kind": "text",
+					"nonce_encoding": null,
+					"signature": null,
+					"required_hash_data_fields": null
+				},
+				"nonce": "37",
+				"hash_sum": "005d372c56e6c6b52ad4a8325654692e` +
+				`c9aa3af5f73021748bc3fdb124ae9b20"
+			}`,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/hash sum is absent",
+			fields: fields{
+				challenge: Challenge{
+					leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(5)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+					hash: func() powValueTypes.Hash {
+						value, err :=
+							powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+						require.NoError(test, err)
+
+						return value
+					}(),
+					hashDataLayout: powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+							":{{ .Challenge.SerializedPayload.ToString }}" +
+							":{{ .Nonce.ToString }}",
+					),
+				},
+				nonce: func() powValueTypes.Nonce {
+					value, err := powValueTypes.NewNonce(big.NewInt(37))
+					require.NoError(test, err)
+
+					return value
+				}(),
+				hashSum: mo.None[powValueTypes.HashSum](),
+			},
+			want: `{
+				"challenge": {
+					"leading_zero_bit_count": 5,
+					"target": null,
+					"created_at": null,
+					"ttl": null,
+					"resource": null,
+					"serialized_payload": "dummy",
+					"hash_name": "SHA-256",
+					"hash_data_layout": "{{.Challenge.LeadingZeroBitCount.ToInt}}` +
+				`:{{.Challenge.SerializedPayload.ToString}}` +
+				`:{{.Nonce.ToString}}",
+					"hash_data_layout_kind": "text",
+					"nonce_encoding": null,
+					"signature": null,
+					"required_hash_data_fields": null
+				},
+				"nonce": "37",
+				"hash_sum": null
+			}`,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/nonce with an encoding",
+			fields: fields{
+				challenge: Challenge{
+					leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(5)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+					hash: func() powValueTypes.Hash {
+						value, err :=
+							powValueTypes.NewHashWithName(sha256.New(), "SHA-256")
+						require.NoError(test, err)
+
+						return value
+					}(),
+					hashDataLayout: powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+							":{{ .Challenge.SerializedPayload.ToString }}" +
+							":{{ .Nonce.ToString }}",
+					),
+					nonceEncoding: func() mo.Option[powValueTypes.NonceEncoding] {
+						value, err :=
+							powValueTypes.NewNonceEncoding(powValueTypes.NonceEncodingKindHex)
+						require.NoError(test, err)
+
+						return mo.Some(value)
+					}(),
+				},
+				nonce: func() powValueTypes.Nonce {
+					value, err := powValueTypes.NewNonce(big.NewInt(37))
+					require.NoError(test, err)
+
+					encoding, err :=
+						powValueTypes.NewNonceEncoding(powValueTypes.NonceEncodingKindHex)
+					require.NoError(test, err)
+
+					return value.WithEncoding(encoding)
+				}(),
+				hashSum: mo.None[powValueTypes.HashSum](),
+			},
+			want: `{
+				"challenge": {
+					"leading_zero_bit_count": 5,
+					"target": null,
+					"created_at": null,
+					"ttl": null,
+					"resource": null,
+					"serialized_payload": "dummy",
+					"hash_name": "SHA-256",
+					"hash_data_layout": "{{.Challenge.LeadingZeroBitCount.ToInt}}` +
+				`:{{.Challenge.SerializedPayload.ToString}}` +
+				`:{{.Nonce.ToString}}",
+					"hash_data_layout_kind": "text",
+					"nonce_encoding": "hex",
+					"signature": null,
+					"required_hash_data_fields": null
+				},
+				"nonce": "37",
+				"hash_sum": null
+			}`,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/hash without an explicit name",
+			fields: fields{
+				challenge: Challenge{
+					leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(5)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+					hash:              powValueTypes.NewHash(sha256.New()),
+					hashDataLayout: powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+							":{{ .Challenge.SerializedPayload.ToString }}" +
+							":{{ .Nonce.ToString }}",
+					),
+				},
+				nonce: func() powValueTypes.Nonce {
+					value, err := powValueTypes.NewNonce(big.NewInt(37))
+					require.NoError(test, err)
+
+					return value
+				}(),
+				hashSum: mo.None[powValueTypes.HashSum](),
+			},
+			want:    "",
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			entity := Solution{
+				challenge: data.fields.challenge,
+				nonce:     data.fields.nonce,
+				hashSum:   data.fields.hashSum,
+			}
+			got, err := json.Marshal(entity)
+
+			data.wantErr(test, err)
+			if err == nil {
+				assert.JSONEq(test, data.want, string(got))
+			} else {
+				assert.Nil(test, got)
+			}
+		})
+	}
+}
+
+func TestSolution_UnmarshalJSON(test *testing.T) {
+	type args struct {
+		data string
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/hash sum is present",
+			args: args{
+				data: `{
+					"challenge": {
+						"leading_zero_bit_count": 5,
+						"serialized_payload": "dummy",
+						"hash_name": "SHA-256",
+						"hash_data_layout": "{{.Challenge.LeadingZeroBitCount.ToInt}}` +
+					`:{{.Challenge.SerializedPayload.ToString}}` +
+					`:{{.Nonce.ToString}}"
+					},
+					"nonce": "37",
+					"hash_sum": "005d372c56e6c6b52ad4a8325654692e` +
+					`c9aa3af5f73021748bc3fdb124ae9b20"
+				}`,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/hash sum is absent",
+			args: args{
+				data: `{
+					"challenge": {
+						"leading_zero_bit_count": 5,
+						"serialized_payload": "dummy",
+						"hash_name": "SHA-256",
+						"hash_data_layout": "{{.Challenge.LeadingZeroBitCount.ToInt}}` +
+					`:{{.Challenge.SerializedPayload.ToString}}` +
+					`:{{.Nonce.ToString}}"
+					},
+					"nonce": "37"
+				}`,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/invalid JSON",
+			args: args{
+				data: `dummy`,
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/invalid fields",
+			args: args{
+				data: `{
+					"challenge": {
+						"leading_zero_bit_count": 5,
+						"serialized_payload": "dummy",
+						"hash_name": "SHA-256",
+						"hash_data_layout": "{{.Challenge.LeadingZeroBitCount.ToInt}}` +
+					`:{{.Challenge.SerializedPayload.ToString}}` +
+					`:{{.Nonce.ToString}}"
+					},
+					"nonce": "dummy",
+					"hash_sum": "dummy"
+				}`,
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/unable to build the solution",
+			args: args{
+				data: `{
+					"challenge": {
+						"leading_zero_bit_count": 5,
+						"serialized_payload": "dummy",
+						"hash_name": "SHA-256",
+						"hash_data_layout": "{{.Challenge.LeadingZeroBitCount.ToInt}}` +
+					`:{{.Challenge.SerializedPayload.ToString}}` +
+					`:{{.Nonce.ToString}}"
+					},
+					"nonce": "37",
+					"hash_sum": "64756d6d79"
+				}`,
+			},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			var entity Solution
+			err := json.Unmarshal([]byte(data.args.data), &entity)
+
+			data.wantErr(test, err)
+			if err == nil {
+				assert.NoError(test, entity.Verify())
+			}
+		})
+	}
+}
+
+func TestUnmarshalSolutionJSON(test *testing.T) {
+	type args struct {
+		data         string
+		hashRegistry *powValueTypes.HashRegistry
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				data: `{
+					"challenge": {
+						"leading_zero_bit_count": 5,
+						"serialized_payload": "dummy",
+						"hash_name": "SHA-256",
+						"hash_data_layout": "{{.Challenge.LeadingZeroBitCount.ToInt}}` +
+					`:{{.Challenge.SerializedPayload.ToString}}` +
+					`:{{.Nonce.ToString}}"
+					},
+					"nonce": "37"
+				}`,
+				hashRegistry: func() *powValueTypes.HashRegistry {
+					registry, err :=
+						powValueTypes.DefaultHashRegistry.Restricted("SHA-256")
+					require.NoError(test, err)
+
+					return registry
+				}(),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error",
+			args: args{
+				data: `{
+					"challenge": {
+						"leading_zero_bit_count": 5,
+						"serialized_payload": "dummy",
+						"hash_name": "SHA-256",
+						"hash_data_layout": "{{.Challenge.LeadingZeroBitCount.ToInt}}` +
+					`:{{.Challenge.SerializedPayload.ToString}}` +
+					`:{{.Nonce.ToString}}"
+					},
+					"nonce": "37"
+				}`,
+				hashRegistry: func() *powValueTypes.HashRegistry {
+					registry, err :=
+						powValueTypes.DefaultHashRegistry.Restricted("SHA-512")
+					require.NoError(test, err)
+
+					return registry
+				}(),
+			},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err := UnmarshalSolutionJSON(
+				[]byte(data.args.data),
+				data.args.hashRegistry,
+			)
+
+			data.wantErr(test, err)
+			if err == nil {
+				assert.NoError(test, got.Verify())
+			}
+		})
+	}
+}
+
+func TestSolution_JSON_roundTrip(test *testing.T) {
+	leadingZeroBitCount, err := powValueTypes.NewLeadingZeroBitCount(5)
+	require.NoError(test, err)
+
+	hash, err := powValueTypes.DefaultHashRegistry.Lookup("SHA-256")
+	require.NoError(test, err)
+
+	challenge, err := NewChallengeBuilder().
+		SetLeadingZeroBitCount(leadingZeroBitCount).
+		SetSerializedPayload(powValueTypes.NewSerializedPayload("dummy")).
+		SetHash(hash).
+		SetHashDataLayout(powValueTypes.MustParseHashDataLayout(
+			"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+				":{{ .Challenge.SerializedPayload.ToString }}" +
+				":{{ .Challenge.Hash.Name }}" +
+				":{{ .Challenge.HashDataLayout.ToString }}" +
+				":{{ .Nonce.ToString }}",
+		)).
+		Build()
+	require.NoError(test, err)
+
+	solution, err := challenge.Solve(context.Background(), SolveParams{})
+	require.NoError(test, err)
+
+	data, err := json.Marshal(solution)
+	require.NoError(test, err)
+
+	var got Solution
+	err = json.Unmarshal(data, &got)
+	require.NoError(test, err)
+
+	assert.Equal(test, solution.Nonce(), got.Nonce())
+	assert.Equal(test, solution.HashSum(), got.HashSum())
+	assert.NoError(test, got.Verify())
+}
@@ -3,7 +3,9 @@ package pow
 import (
 	"crypto/sha256"
 	"math/big"
+	"net/url"
 	"testing"
+	"time"
 
 	"github.com/samber/mo"
 	"github.com/stretchr/testify/assert"
@@ -64,6 +66,15 @@ func TestSolution_Challenge(test *testing.T) {
 			}
 			got := entity.Challenge()
 
+			assert.Equal(
+				test,
+				data.want.hashDataLayout.ToString(),
+				got.hashDataLayout.ToString(),
+			)
+			assert.Equal(test, data.want.hashDataLayout.Kind(), got.hashDataLayout.Kind())
+
+			data.want.hashDataLayout = powValueTypes.HashDataLayout{}
+			got.hashDataLayout = powValueTypes.HashDataLayout{}
 			assert.Equal(test, data.want, got)
 		})
 	}
@@ -217,6 +228,43 @@ func TestSolution_Verify(test *testing.T) {
 			},
 			wantErr: assert.NoError,
 		},
+		{
+			name: "success/arbitrary target",
+			fields: fields{
+				challenge: Challenge{
+					leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(5)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					arbitraryTarget: mo.Some(func() powValueTypes.Target {
+						value, err := powValueTypes.ParseTarget(
+							"005d372c56e6c6b52ad4a8325654692e" +
+								"c9aa3af5f73021748bc3fdb124ae9b21",
+						)
+						require.NoError(test, err)
+
+						return value
+					}()),
+					serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+					hash:              powValueTypes.NewHash(sha256.New()),
+					hashDataLayout: powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+							":{{ .Challenge.SerializedPayload.ToString }}" +
+							":{{ .Nonce.ToString }}",
+					),
+				},
+				nonce: func() powValueTypes.Nonce {
+					value, err := powValueTypes.NewNonce(big.NewInt(37))
+					require.NoError(test, err)
+
+					return value
+				}(),
+				hashSum: mo.None[powValueTypes.HashSum](),
+			},
+			wantErr: assert.NoError,
+		},
 		{
 			name: "error/unable to execute the hash data layout",
 			fields: fields{
@@ -336,6 +384,45 @@ func TestSolution_Verify(test *testing.T) {
 				return assert.ErrorIs(test, err, powErrors.ErrValidationFailure)
 			},
 		},
+		{
+			name: "error/hash sum doesn't fit the arbitrary target",
+			fields: fields{
+				challenge: Challenge{
+					leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(5)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					arbitraryTarget: mo.Some(func() powValueTypes.Target {
+						value, err := powValueTypes.ParseTarget(
+							"005d372c56e6c6b52ad4a8325654692e" +
+								"c9aa3af5f73021748bc3fdb124ae9b20",
+						)
+						require.NoError(test, err)
+
+						return value
+					}()),
+					serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+					hash:              powValueTypes.NewHash(sha256.New()),
+					hashDataLayout: powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+							":{{ .Challenge.SerializedPayload.ToString }}" +
+							":{{ .Nonce.ToString }}",
+					),
+				},
+				nonce: func() powValueTypes.Nonce {
+					value, err := powValueTypes.NewNonce(big.NewInt(37))
+					require.NoError(test, err)
+
+					return value
+				}(),
+				hashSum: mo.None[powValueTypes.HashSum](),
+			},
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrValidationFailure)
+			},
+		},
 	} {
 		test.Run(data.name, func(test *testing.T) {
 			entity := Solution{
@@ -349,3 +436,436 @@ func TestSolution_Verify(test *testing.T) {
 		})
 	}
 }
+
+func TestSolution_VerifyWithParams(test *testing.T) {
+	type fields struct {
+		challenge Challenge
+		nonce     powValueTypes.Nonce
+	}
+	type args struct {
+		params VerifyParams
+	}
+
+	for _, data := range []struct {
+		name    string
+		fields  fields
+		args    args
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/with the expected resource",
+			fields: fields{
+				challenge: Challenge{
+					leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(5)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					createdAt: func() mo.Option[powValueTypes.CreatedAt] {
+						value, err := powValueTypes.NewCreatedAt(
+							time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC),
+						)
+						require.NoError(test, err)
+
+						return mo.Some(value)
+					}(),
+					ttl: func() mo.Option[powValueTypes.TTL] {
+						value, err := powValueTypes.NewTTL(time.Hour)
+						require.NoError(test, err)
+
+						return mo.Some(value)
+					}(),
+					resource: mo.Some(powValueTypes.NewResource(&url.URL{
+						Scheme: "https",
+						Host:   "example.com",
+						Path:   "/",
+					})),
+					serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+					hash:              powValueTypes.NewHash(sha256.New()),
+					hashDataLayout: powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+							":{{ .Challenge.SerializedPayload.ToString }}" +
+							":{{ .Nonce.ToString }}",
+					),
+				},
+				nonce: func() powValueTypes.Nonce {
+					value, err := powValueTypes.NewNonce(big.NewInt(37))
+					require.NoError(test, err)
+
+					return value
+				}(),
+			},
+			args: args{
+				params: VerifyParams{
+					Clock: mo.Some[Clock](NewFixedClock(
+						time.Date(2000, time.January, 2, 3, 34, 5, 6, time.UTC),
+					)),
+					ExpectedResource: mo.Some(powValueTypes.NewResource(&url.URL{
+						Scheme: "https",
+						Host:   "example.com",
+						Path:   "/",
+					})),
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/without the expected resource",
+			fields: fields{
+				challenge: Challenge{
+					leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(5)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					createdAt: func() mo.Option[powValueTypes.CreatedAt] {
+						value, err := powValueTypes.NewCreatedAt(
+							time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC),
+						)
+						require.NoError(test, err)
+
+						return mo.Some(value)
+					}(),
+					ttl: func() mo.Option[powValueTypes.TTL] {
+						value, err := powValueTypes.NewTTL(time.Hour)
+						require.NoError(test, err)
+
+						return mo.Some(value)
+					}(),
+					resource:          mo.None[powValueTypes.Resource](),
+					serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+					hash:              powValueTypes.NewHash(sha256.New()),
+					hashDataLayout: powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+							":{{ .Challenge.SerializedPayload.ToString }}" +
+							":{{ .Nonce.ToString }}",
+					),
+				},
+				nonce: func() powValueTypes.Nonce {
+					value, err := powValueTypes.NewNonce(big.NewInt(37))
+					require.NoError(test, err)
+
+					return value
+				}(),
+			},
+			args: args{
+				params: VerifyParams{
+					Clock: mo.Some[Clock](NewFixedClock(
+						time.Date(2000, time.January, 2, 3, 34, 5, 6, time.UTC),
+					)),
+					ExpectedResource: mo.None[powValueTypes.Resource](),
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/challenge is expired/out of the TTL",
+			fields: fields{
+				challenge: Challenge{
+					leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(5)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					createdAt: func() mo.Option[powValueTypes.CreatedAt] {
+						value, err := powValueTypes.NewCreatedAt(
+							time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC),
+						)
+						require.NoError(test, err)
+
+						return mo.Some(value)
+					}(),
+					ttl: func() mo.Option[powValueTypes.TTL] {
+						value, err := powValueTypes.NewTTL(time.Hour)
+						require.NoError(test, err)
+
+						return mo.Some(value)
+					}(),
+					resource: mo.Some(powValueTypes.NewResource(&url.URL{
+						Scheme: "https",
+						Host:   "example.com",
+						Path:   "/",
+					})),
+					serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+					hash:              powValueTypes.NewHash(sha256.New()),
+					hashDataLayout: powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+							":{{ .Challenge.SerializedPayload.ToString }}" +
+							":{{ .Nonce.ToString }}",
+					),
+				},
+				nonce: func() powValueTypes.Nonce {
+					value, err := powValueTypes.NewNonce(big.NewInt(37))
+					require.NoError(test, err)
+
+					return value
+				}(),
+			},
+			args: args{
+				params: VerifyParams{
+					Clock: mo.Some[Clock](NewFixedClock(
+						time.Date(2000, time.January, 2, 5, 4, 5, 6, time.UTC),
+					)),
+					ExpectedResource: mo.Some(powValueTypes.NewResource(&url.URL{
+						Scheme: "https",
+						Host:   "example.com",
+						Path:   "/",
+					})),
+				},
+			},
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrChallengeExpired)
+			},
+		},
+		{
+			name: "error/challenge is expired/out of the clock skew tolerance",
+			fields: fields{
+				challenge: Challenge{
+					leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(5)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					createdAt: func() mo.Option[powValueTypes.CreatedAt] {
+						value, err := powValueTypes.NewCreatedAt(
+							time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC),
+						)
+						require.NoError(test, err)
+
+						return mo.Some(value)
+					}(),
+					ttl: func() mo.Option[powValueTypes.TTL] {
+						value, err := powValueTypes.NewTTL(time.Hour)
+						require.NoError(test, err)
+
+						return mo.Some(value)
+					}(),
+					resource: mo.Some(powValueTypes.NewResource(&url.URL{
+						Scheme: "https",
+						Host:   "example.com",
+						Path:   "/",
+					})),
+					serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+					hash:              powValueTypes.NewHash(sha256.New()),
+					hashDataLayout: powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+							":{{ .Challenge.SerializedPayload.ToString }}" +
+							":{{ .Nonce.ToString }}",
+					),
+				},
+				nonce: func() powValueTypes.Nonce {
+					value, err := powValueTypes.NewNonce(big.NewInt(37))
+					require.NoError(test, err)
+
+					return value
+				}(),
+			},
+			args: args{
+				params: VerifyParams{
+					Clock: mo.Some[Clock](NewFixedClock(
+						time.Date(2000, time.January, 2, 2, 4, 5, 6, time.UTC),
+					)),
+					ClockSkewTolerance: mo.Some(time.Minute),
+					ExpectedResource: mo.Some(powValueTypes.NewResource(&url.URL{
+						Scheme: "https",
+						Host:   "example.com",
+						Path:   "/",
+					})),
+				},
+			},
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrChallengeExpired)
+			},
+		},
+		{
+			name: "error/resource doesn't match the expected one",
+			fields: fields{
+				challenge: Challenge{
+					leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(5)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					createdAt: func() mo.Option[powValueTypes.CreatedAt] {
+						value, err := powValueTypes.NewCreatedAt(
+							time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC),
+						)
+						require.NoError(test, err)
+
+						return mo.Some(value)
+					}(),
+					ttl: func() mo.Option[powValueTypes.TTL] {
+						value, err := powValueTypes.NewTTL(time.Hour)
+						require.NoError(test, err)
+
+						return mo.Some(value)
+					}(),
+					resource: mo.Some(powValueTypes.NewResource(&url.URL{
+						Scheme: "https",
+						Host:   "example.com",
+						Path:   "/",
+					})),
+					serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+					hash:              powValueTypes.NewHash(sha256.New()),
+					hashDataLayout: powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+							":{{ .Challenge.SerializedPayload.ToString }}" +
+							":{{ .Nonce.ToString }}",
+					),
+				},
+				nonce: func() powValueTypes.Nonce {
+					value, err := powValueTypes.NewNonce(big.NewInt(37))
+					require.NoError(test, err)
+
+					return value
+				}(),
+			},
+			args: args{
+				params: VerifyParams{
+					Clock: mo.Some[Clock](NewFixedClock(
+						time.Date(2000, time.January, 2, 3, 34, 5, 6, time.UTC),
+					)),
+					ExpectedResource: mo.Some(powValueTypes.NewResource(&url.URL{
+						Scheme: "https",
+						Host:   "example.com",
+						Path:   "/other",
+					})),
+				},
+			},
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrResourceMismatch)
+			},
+		},
+		{
+			name: "error/resource is absent",
+			fields: fields{
+				challenge: Challenge{
+					leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(5)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					createdAt: func() mo.Option[powValueTypes.CreatedAt] {
+						value, err := powValueTypes.NewCreatedAt(
+							time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC),
+						)
+						require.NoError(test, err)
+
+						return mo.Some(value)
+					}(),
+					ttl: func() mo.Option[powValueTypes.TTL] {
+						value, err := powValueTypes.NewTTL(time.Hour)
+						require.NoError(test, err)
+
+						return mo.Some(value)
+					}(),
+					resource:          mo.None[powValueTypes.Resource](),
+					serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+					hash:              powValueTypes.NewHash(sha256.New()),
+					hashDataLayout: powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+							":{{ .Challenge.SerializedPayload.ToString }}" +
+							":{{ .Nonce.ToString }}",
+					),
+				},
+				nonce: func() powValueTypes.Nonce {
+					value, err := powValueTypes.NewNonce(big.NewInt(37))
+					require.NoError(test, err)
+
+					return value
+				}(),
+			},
+			args: args{
+				params: VerifyParams{
+					Clock: mo.Some[Clock](NewFixedClock(
+						time.Date(2000, time.January, 2, 3, 34, 5, 6, time.UTC),
+					)),
+					ExpectedResource: mo.Some(powValueTypes.NewResource(&url.URL{
+						Scheme: "https",
+						Host:   "example.com",
+						Path:   "/",
+					})),
+				},
+			},
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrResourceMismatch)
+			},
+		},
+		{
+			name: "error/hash sum doesn't fit the target",
+			fields: fields{
+				challenge: Challenge{
+					leadingZeroBitCount: func() powValueTypes.LeadingZeroBitCount {
+						value, err := powValueTypes.NewLeadingZeroBitCount(5)
+						require.NoError(test, err)
+
+						return value
+					}(),
+					createdAt: func() mo.Option[powValueTypes.CreatedAt] {
+						value, err := powValueTypes.NewCreatedAt(
+							time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC),
+						)
+						require.NoError(test, err)
+
+						return mo.Some(value)
+					}(),
+					ttl: func() mo.Option[powValueTypes.TTL] {
+						value, err := powValueTypes.NewTTL(time.Hour)
+						require.NoError(test, err)
+
+						return mo.Some(value)
+					}(),
+					resource: mo.Some(powValueTypes.NewResource(&url.URL{
+						Scheme: "https",
+						Host:   "example.com",
+						Path:   "/",
+					})),
+					serializedPayload: powValueTypes.NewSerializedPayload("dummy"),
+					hash:              powValueTypes.NewHash(sha256.New()),
+					hashDataLayout: powValueTypes.MustParseHashDataLayout(
+						"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+							":{{ .Challenge.SerializedPayload.ToString }}" +
+							":{{ .Nonce.ToString }}",
+					),
+				},
+				nonce: func() powValueTypes.Nonce {
+					value, err := powValueTypes.NewNonce(big.NewInt(23))
+					require.NoError(test, err)
+
+					return value
+				}(),
+			},
+			args: args{
+				params: VerifyParams{
+					Clock: mo.Some[Clock](NewFixedClock(
+						time.Date(2000, time.January, 2, 3, 34, 5, 6, time.UTC),
+					)),
+					ExpectedResource: mo.Some(powValueTypes.NewResource(&url.URL{
+						Scheme: "https",
+						Host:   "example.com",
+						Path:   "/",
+					})),
+				},
+			},
+			wantErr: func(test assert.TestingT, err error, msgAndArgs ...any) bool {
+				return assert.ErrorIs(test, err, powErrors.ErrValidationFailure)
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			entity := Solution{
+				challenge: data.fields.challenge,
+				nonce:     data.fields.nonce,
+			}
+			err := entity.VerifyWithParams(data.args.params)
+
+			data.wantErr(test, err)
+		})
+	}
+}
@@ -0,0 +1,364 @@
+package powValueTypes
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/samber/mo"
+)
+
+const (
+	BinaryHashDataFieldSeparator = ","
+	MaxBinaryNonceWidthInBytes   = 64
+)
+
+type BinaryHashData struct {
+	LeadingZeroBitCount LeadingZeroBitCount
+	Target              Target
+	CreatedAt           mo.Option[CreatedAt]
+	TTL                 mo.Option[TTL]
+	Resource            mo.Option[Resource]
+	SerializedPayload   SerializedPayload
+	Nonce               Nonce
+}
+
+type BinaryHashDataSource interface {
+	ToBinaryHashData() (BinaryHashData, error)
+}
+
+// the layout is a sequence of fields separated by commas:
+//   - `nonce:<width>`: the nonce as a big-endian unsigned integer
+//     of the fixed width in bytes;
+//   - `leading-zero-bit-count`: a big-endian `uint16`;
+//   - `target`: a big-endian unsigned integer prefixed with its length;
+//   - `created-at`: a presence flag, then the Unix time as a big-endian
+//     `int64` of seconds and a big-endian `uint32` of nanoseconds;
+//   - `ttl`: a presence flag, then a big-endian `int64` of nanoseconds;
+//   - `resource`: a presence flag, then the URL prefixed with its length;
+//   - `payload`: the serialized payload prefixed with its length;
+//   - `literal:<hex>`: constant bytes (for example, a protocol tag);
+//
+// all the lengths are big-endian `uint32`; the presence flag is a single byte
+// equal to 0 or 1
+type BinaryHashDataLayout struct {
+	fields []binaryHashDataField
+}
+
+func ParseBinaryHashDataLayout(rawValue string) (BinaryHashDataLayout, error) {
+	var fields []binaryHashDataField
+	var errs []error
+	for fieldIndex, rawField := range strings.Split(
+		rawValue,
+		BinaryHashDataFieldSeparator,
+	) {
+		field, err := parseBinaryHashDataField(strings.TrimSpace(rawField))
+		if err != nil {
+			errs = append(
+				errs,
+				fmt.Errorf("unable to parse the field #%d: %w", fieldIndex, err),
+			)
+			continue
+		}
+
+		fields = append(fields, field)
+	}
+	if len(errs) > 0 {
+		return BinaryHashDataLayout{}, errors.Join(errs...)
+	}
+
+	value := BinaryHashDataLayout{
+		fields: fields,
+	}
+	return value, nil
+}
+
+func MustParseBinaryHashDataLayout(rawValue string) BinaryHashDataLayout {
+	value, err := ParseBinaryHashDataLayout(rawValue)
+	if err != nil {
+		panic(fmt.Sprintf(
+			"powValueTypes.MustParseBinaryHashDataLayout(): %s",
+			err,
+		))
+	}
+
+	return value
+}
+
+func (value BinaryHashDataLayout) Execute(data BinaryHashData) ([]byte, error) {
+	var buffer []byte
+	for fieldIndex, field := range value.fields {
+		var err error
+		buffer, err = field.appendTo(buffer, data)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"unable to write the field #%d (%s): %w",
+				fieldIndex,
+				field.toString(),
+				err,
+			)
+		}
+	}
+
+	return buffer, nil
+}
+
+func (value BinaryHashDataLayout) ToString() string {
+	rawFields := make([]string, 0, len(value.fields))
+	for _, field := range value.fields {
+		rawFields = append(rawFields, field.toString())
+	}
+
+	return strings.Join(rawFields, BinaryHashDataFieldSeparator)
+}
+
+type binaryHashDataField interface {
+	appendTo(buffer []byte, data BinaryHashData) ([]byte, error)
+	hashDataField() mo.Option[HashDataField]
+	toString() string
+}
+
+func parseBinaryHashDataField(rawValue string) (binaryHashDataField, error) {
+	name, argument, isArgumentPresent := strings.Cut(rawValue, ":")
+	if isArgumentPresent {
+		switch name {
+		case "nonce":
+			width, err := strconv.Atoi(argument)
+			if err != nil {
+				return nil, fmt.Errorf("unable to parse the nonce width: %w", err)
+			}
+			if width <= 0 || width > MaxBinaryNonceWidthInBytes {
+				return nil, fmt.Errorf(
+					"nonce width must be in the range [1, %d]",
+					MaxBinaryNonceWidthInBytes,
+				)
+			}
+
+			return binaryNonceField{widthInBytes: width}, nil
+
+		case "literal":
+			bytes, err := hex.DecodeString(argument)
+			if err != nil {
+				return nil, fmt.Errorf("unable to decode the literal: %w", err)
+			}
+			if len(bytes) == 0 {
+				return nil, errors.New("literal cannot be empty")
+			}
+
+			return binaryLiteralField{bytes: bytes}, nil
+		}
+
+		return nil, fmt.Errorf("field %q doesn't accept an argument", name)
+	}
+
+	switch name {
+	case "leading-zero-bit-count":
+		return binaryLeadingZeroBitCountField{}, nil
+	case "target":
+		return binaryTargetField{}, nil
+	case "created-at":
+		return binaryCreatedAtField{}, nil
+	case "ttl":
+		return binaryTTLField{}, nil
+	case "resource":
+		return binaryResourceField{}, nil
+	case "payload":
+		return binaryPayloadField{}, nil
+	case "nonce", "literal":
+		return nil, fmt.Errorf("field %q requires an argument", name)
+	}
+
+	return nil, fmt.Errorf("unknown field %q", name)
+}
+
+type binaryNonceField struct {
+	widthInBytes int
+}
+
+func (field binaryNonceField) appendTo(
+	buffer []byte,
+	data BinaryHashData,
+) ([]byte, error) {
+	rawNonce := data.Nonce.ToBigInt()
+	if rawNonce.BitLen() > field.widthInBytes*8 {
+		return nil, errors.New("nonce exceeds the field width")
+	}
+
+	buffer = append(buffer, make([]byte, field.widthInBytes)...)
+	rawNonce.FillBytes(buffer[len(buffer)-field.widthInBytes:])
+
+	return buffer, nil
+}
+
+func (field binaryNonceField) hashDataField() mo.Option[HashDataField] {
+	return mo.Some(HashDataFieldNonce)
+}
+
+func (field binaryNonceField) toString() string {
+	return "nonce:" + strconv.Itoa(field.widthInBytes)
+}
+
+type binaryLeadingZeroBitCountField struct{}
+
+func (field binaryLeadingZeroBitCountField) appendTo(
+	buffer []byte,
+	data BinaryHashData,
+) ([]byte, error) {
+	rawValue := data.LeadingZeroBitCount.ToInt()
+	if rawValue > math.MaxUint16 {
+		return nil, errors.New("leading zero bit count exceeds `uint16`")
+	}
+
+	return binary.BigEndian.AppendUint16(buffer, uint16(rawValue)), nil
+}
+
+func (field binaryLeadingZeroBitCountField) hashDataField() mo.Option[HashDataField] { //nolint:lll
+	return mo.Some(HashDataFieldDifficulty)
+}
+
+func (field binaryLeadingZeroBitCountField) toString() string {
+	return "leading-zero-bit-count"
+}
+
+type binaryTargetField struct{}
+
+func (field binaryTargetField) appendTo(
+	buffer []byte,
+	data BinaryHashData,
+) ([]byte, error) {
+	return appendLengthPrefixed(buffer, data.Target.ToBigInt().Bytes())
+}
+
+func (field binaryTargetField) hashDataField() mo.Option[HashDataField] {
+	return mo.Some(HashDataFieldDifficulty)
+}
+
+func (field binaryTargetField) toString() string {
+	return "target"
+}
+
+type binaryCreatedAtField struct{}
+
+func (field binaryCreatedAtField) appendTo(
+	buffer []byte,
+	data BinaryHashData,
+) ([]byte, error) {
+	createdAt, isPresent := data.CreatedAt.Get()
+	if !isPresent {
+		return append(buffer, 0), nil
+	}
+
+	moment := createdAt.ToTime()
+	buffer = append(buffer, 1)
+	buffer = binary.BigEndian.AppendUint64(buffer, uint64(moment.Unix()))
+	buffer = binary.BigEndian.AppendUint32(buffer, uint32(moment.Nanosecond()))
+
+	return buffer, nil
+}
+
+func (field binaryCreatedAtField) hashDataField() mo.Option[HashDataField] {
+	return mo.Some(HashDataFieldCreatedAt)
+}
+
+func (field binaryCreatedAtField) toString() string {
+	return "created-at"
+}
+
+type binaryTTLField struct{}
+
+func (field binaryTTLField) appendTo(
+	buffer []byte,
+	data BinaryHashData,
+) ([]byte, error) {
+	ttl, isPresent := data.TTL.Get()
+	if !isPresent {
+		return append(buffer, 0), nil
+	}
+
+	buffer = append(buffer, 1)
+	buffer = binary.BigEndian.AppendUint64(buffer, uint64(ttl.ToDuration()))
+
+	return buffer, nil
+}
+
+func (field binaryTTLField) hashDataField() mo.Option[HashDataField] {
+	return mo.Some(HashDataFieldTTL)
+}
+
+func (field binaryTTLField) toString() string {
+	return "ttl"
+}
+
+type binaryResourceField struct{}
+
+func (field binaryResourceField) appendTo(
+	buffer []byte,
+	data BinaryHashData,
+) ([]byte, error) {
+	resource, isPresent := data.Resource.Get()
+	if !isPresent {
+		return append(buffer, 0), nil
+	}
+
+	return appendLengthPrefixed(append(buffer, 1), []byte(resource.ToString()))
+}
+
+func (field binaryResourceField) hashDataField() mo.Option[HashDataField] {
+	return mo.Some(HashDataFieldResource)
+}
+
+func (field binaryResourceField) toString() string {
+	return "resource"
+}
+
+type binaryPayloadField struct{}
+
+func (field binaryPayloadField) appendTo(
+	buffer []byte,
+	data BinaryHashData,
+) ([]byte, error) {
+	return appendLengthPrefixed(
+		buffer,
+		[]byte(data.SerializedPayload.ToString()),
+	)
+}
+
+func (field binaryPayloadField) hashDataField() mo.Option[HashDataField] {
+	return mo.Some(HashDataFieldPayload)
+}
+
+func (field binaryPayloadField) toString() string {
+	return "payload"
+}
+
+type binaryLiteralField struct {
+	bytes []byte
+}
+
+func (field binaryLiteralField) appendTo(
+	buffer []byte,
+	data BinaryHashData,
+) ([]byte, error) {
+	return append(buffer, field.bytes...), nil
+}
+
+func (field binaryLiteralField) hashDataField() mo.Option[HashDataField] {
+	return mo.None[HashDataField]()
+}
+
+func (field binaryLiteralField) toString() string {
+	return "literal:" + hex.EncodeToString(field.bytes)
+}
+
+func appendLengthPrefixed(buffer []byte, value []byte) ([]byte, error) {
+	if uint64(len(value)) > math.MaxUint32 {
+		return nil, errors.New("value length exceeds `uint32`")
+	}
+
+	buffer = binary.BigEndian.AppendUint32(buffer, uint32(len(value)))
+	return append(buffer, value...), nil
+}
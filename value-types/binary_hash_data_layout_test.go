@@ -0,0 +1,362 @@
+package powValueTypes
+
+import (
+	"math/big"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/samber/mo"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseBinaryHashDataLayout(test *testing.T) {
+	type args struct {
+		rawValue string
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		want    string
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/all fields",
+			args: args{
+				rawValue: "literal:00ff,leading-zero-bit-count,target,created-at,ttl," +
+					"resource,payload,nonce:8",
+			},
+			want: "literal:00ff,leading-zero-bit-count,target,created-at,ttl," +
+				"resource,payload,nonce:8",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/with spaces",
+			args: args{
+				rawValue: " payload , nonce:4 ",
+			},
+			want:    "payload,nonce:4",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/literal normalization",
+			args: args{
+				rawValue: "literal:ABCD",
+			},
+			want:    "literal:abcd",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/unknown field",
+			args: args{
+				rawValue: "payload,dummy",
+			},
+			want:    "",
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/empty field",
+			args: args{
+				rawValue: "payload,,nonce:8",
+			},
+			want:    "",
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/nonce without a width",
+			args: args{
+				rawValue: "nonce",
+			},
+			want:    "",
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/invalid nonce width",
+			args: args{
+				rawValue: "nonce:dummy",
+			},
+			want:    "",
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/zero nonce width",
+			args: args{
+				rawValue: "nonce:0",
+			},
+			want:    "",
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/too large nonce width",
+			args: args{
+				rawValue: "nonce:65",
+			},
+			want:    "",
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/invalid literal",
+			args: args{
+				rawValue: "literal:dummy",
+			},
+			want:    "",
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/empty literal",
+			args: args{
+				rawValue: "literal:",
+			},
+			want:    "",
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/unexpected argument",
+			args: args{
+				rawValue: "payload:23",
+			},
+			want:    "",
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err := ParseBinaryHashDataLayout(data.args.rawValue)
+
+			assert.Equal(test, data.want, got.ToString())
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestMustParseBinaryHashDataLayout(test *testing.T) {
+	type args struct {
+		rawValue string
+	}
+
+	for _, data := range []struct {
+		name      string
+		args      args
+		want      string
+		wantPanic assert.PanicAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				rawValue: "payload,nonce:8",
+			},
+			want:      "payload,nonce:8",
+			wantPanic: assert.NotPanics,
+		},
+		{
+			name: "error",
+			args: args{
+				rawValue: "dummy",
+			},
+			want:      "",
+			wantPanic: assert.Panics,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			var got BinaryHashDataLayout
+			data.wantPanic(test, func() {
+				got = MustParseBinaryHashDataLayout(data.args.rawValue)
+			})
+
+			assert.Equal(test, data.want, got.ToString())
+		})
+	}
+}
+
+func TestBinaryHashDataLayout_Execute(test *testing.T) {
+	type args struct {
+		data BinaryHashData
+	}
+
+	for _, data := range []struct {
+		name     string
+		rawValue string
+		args     args
+		want     []byte
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name:     "success/nonce",
+			rawValue: "nonce:4",
+			args: args{
+				data: BinaryHashData{
+					Nonce: Nonce{rawValue: big.NewInt(0x0102)},
+				},
+			},
+			want:    []byte{0x00, 0x00, 0x01, 0x02},
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "success/nonce of the full width",
+			rawValue: "nonce:2",
+			args: args{
+				data: BinaryHashData{
+					Nonce: Nonce{rawValue: big.NewInt(0xffff)},
+				},
+			},
+			want:    []byte{0xff, 0xff},
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "success/leading zero bit count",
+			rawValue: "leading-zero-bit-count",
+			args: args{
+				data: BinaryHashData{
+					LeadingZeroBitCount: LeadingZeroBitCount{rawValue: 0x0102},
+				},
+			},
+			want:    []byte{0x01, 0x02},
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "success/target",
+			rawValue: "target",
+			args: args{
+				data: BinaryHashData{
+					Target: Target{rawValue: big.NewInt(0x010203)},
+				},
+			},
+			want:    []byte{0x00, 0x00, 0x00, 0x03, 0x01, 0x02, 0x03},
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "success/created at/present",
+			rawValue: "created-at",
+			args: args{
+				data: BinaryHashData{
+					CreatedAt: mo.Some(CreatedAt{
+						rawValue: time.Unix(0x01020304, 0x05060708),
+					}),
+				},
+			},
+			want: []byte{
+				0x01,
+				0x00, 0x00, 0x00, 0x00, 0x01, 0x02, 0x03, 0x04,
+				0x05, 0x06, 0x07, 0x08,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "success/created at/absent",
+			rawValue: "created-at",
+			args: args{
+				data: BinaryHashData{},
+			},
+			want:    []byte{0x00},
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "success/TTL/present",
+			rawValue: "ttl",
+			args: args{
+				data: BinaryHashData{
+					TTL: mo.Some(TTL{rawValue: 0x0102}),
+				},
+			},
+			want: []byte{
+				0x01,
+				0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x01, 0x02,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "success/TTL/absent",
+			rawValue: "ttl",
+			args: args{
+				data: BinaryHashData{},
+			},
+			want:    []byte{0x00},
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "success/resource/present",
+			rawValue: "resource",
+			args: args{
+				data: BinaryHashData{
+					Resource: mo.Some(Resource{rawValue: &url.URL{Path: "/a"}}),
+				},
+			},
+			want:    []byte{0x01, 0x00, 0x00, 0x00, 0x02, '/', 'a'},
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "success/resource/absent",
+			rawValue: "resource",
+			args: args{
+				data: BinaryHashData{},
+			},
+			want:    []byte{0x00},
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "success/payload",
+			rawValue: "payload",
+			args: args{
+				data: BinaryHashData{
+					SerializedPayload: SerializedPayload{rawValue: "a:b"},
+				},
+			},
+			want:    []byte{0x00, 0x00, 0x00, 0x03, 'a', ':', 'b'},
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "success/literal",
+			rawValue: "literal:00ff",
+			args: args{
+				data: BinaryHashData{},
+			},
+			want:    []byte{0x00, 0xff},
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "success/several fields",
+			rawValue: "literal:aa,payload,nonce:1",
+			args: args{
+				data: BinaryHashData{
+					SerializedPayload: SerializedPayload{rawValue: "a"},
+					Nonce:             Nonce{rawValue: big.NewInt(23)},
+				},
+			},
+			want:    []byte{0xaa, 0x00, 0x00, 0x00, 0x01, 'a', 23},
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "error/nonce exceeds the field width",
+			rawValue: "nonce:1",
+			args: args{
+				data: BinaryHashData{
+					Nonce: Nonce{rawValue: big.NewInt(0x0100)},
+				},
+			},
+			want:    nil,
+			wantErr: assert.Error,
+		},
+		{
+			name:     "error/leading zero bit count exceeds the field width",
+			rawValue: "leading-zero-bit-count",
+			args: args{
+				data: BinaryHashData{
+					LeadingZeroBitCount: LeadingZeroBitCount{rawValue: 0x010000},
+				},
+			},
+			want:    nil,
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			value, err := ParseBinaryHashDataLayout(data.rawValue)
+			require.NoError(test, err)
+
+			got, err := value.Execute(data.args.data)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
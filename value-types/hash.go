@@ -1,9 +1,12 @@
 package powValueTypes
 
 import (
+	"encoding"
 	"errors"
+	"fmt"
 	"hash"
 	"reflect"
+	"sync"
 
 	"github.com/samber/mo"
 )
@@ -14,12 +17,15 @@ const (
 
 type Hash struct {
 	rawValue hash.Hash
+	pool     *sync.Pool
+	mutex    *sync.Mutex
 	name     mo.Option[string]
 }
 
 func NewHash(rawValue hash.Hash) Hash {
 	return Hash{
 		rawValue: rawValue,
+		mutex:    &sync.Mutex{},
 	}
 }
 
@@ -30,6 +36,30 @@ func NewHashWithName(rawValue hash.Hash, name string) (Hash, error) {
 
 	value := Hash{
 		rawValue: rawValue,
+		mutex:    &sync.Mutex{},
+		name:     mo.Some(name),
+	}
+	return value, nil
+}
+
+func NewHashFromFactory(factory func() hash.Hash) Hash {
+	return Hash{
+		rawValue: factory(),
+		pool:     makeHashPool(factory),
+	}
+}
+
+func NewHashFromFactoryWithName(
+	factory func() hash.Hash,
+	name string,
+) (Hash, error) {
+	if name == "" {
+		return Hash{}, errors.New("hash name cannot be empty")
+	}
+
+	value := Hash{
+		rawValue: factory(),
+		pool:     makeHashPool(factory),
 		name:     mo.Some(name),
 	}
 	return value, nil
@@ -46,6 +76,37 @@ func (value Hash) Name() string {
 	return reflect.TypeOf(value.rawValue).String()
 }
 
+// the name derived from the type of the raw instance isn't explicit,
+// as it depends on the implementation and isn't registered anywhere
+func (value Hash) HasExplicitName() bool {
+	return value.name.IsPresent()
+}
+
+// the clone has its own raw instance, so it can be used concurrently
+// with the original hash without waiting for it; a hash constructed
+// from a factory is returned as is, as it already pools its instances
+func (value Hash) Clone() (Hash, error) {
+	if value.pool != nil {
+		return value, nil
+	}
+
+	var clonedRawValue hash.Hash
+	var err error
+	value.withRawHash(func(rawValue hash.Hash) {
+		clonedRawValue, err = cloneRawHash(rawValue)
+	})
+	if err != nil {
+		return Hash{}, fmt.Errorf("unable to clone the raw instance: %w", err)
+	}
+
+	clonedValue := Hash{
+		rawValue: clonedRawValue,
+		mutex:    &sync.Mutex{},
+		name:     value.name,
+	}
+	return clonedValue, nil
+}
+
 func (value Hash) SizeInBytes() int {
 	return value.rawValue.Size()
 }
@@ -55,11 +116,80 @@ func (value Hash) SizeInBits() int {
 }
 
 func (value Hash) ApplyTo(data string) HashSum {
-	value.rawValue.Reset()
-	value.rawValue.Write([]byte(data))
-	return NewHashSum(value.rawValue.Sum(nil))
+	var hashSum HashSum
+	value.withRawHash(func(rawValue hash.Hash) {
+		hashSum = applyRawHashTo(rawValue, data)
+	})
+
+	return hashSum
 }
 
+// the raw instance is returned as is, i.e. without the serialization
+// of access to it, so it isn't safe for concurrent use with the hash itself
 func (value Hash) ToHash() hash.Hash {
 	return value.rawValue
 }
+
+func (value Hash) withRawHash(handler func(rawValue hash.Hash)) {
+	// if the hash is constructed from a factory, pool its instances,
+	// otherwise serialize access to the single instance
+	if value.pool != nil {
+		rawValue := value.pool.Get().(hash.Hash)
+		defer value.pool.Put(rawValue)
+
+		handler(rawValue)
+		return
+	}
+
+	value.mutex.Lock()
+	defer value.mutex.Unlock()
+
+	handler(value.rawValue)
+}
+
+// the state of the raw instance is restored into a new instance
+// of the same type, so the hash has to support the state marshaling
+// (as the hashes of the standard library do)
+func cloneRawHash(rawValue hash.Hash) (hash.Hash, error) {
+	marshaler, isMarshaler := rawValue.(encoding.BinaryMarshaler)
+	if !isMarshaler {
+		return nil, errors.New("hash doesn't support the state marshaling")
+	}
+
+	rawType := reflect.TypeOf(rawValue)
+	if rawType.Kind() != reflect.Pointer {
+		return nil, errors.New("hash isn't a pointer")
+	}
+
+	clonedRawValue := reflect.New(rawType.Elem()).Interface().(hash.Hash)
+	unmarshaler, isUnmarshaler :=
+		clonedRawValue.(encoding.BinaryUnmarshaler)
+	if !isUnmarshaler {
+		return nil, errors.New("hash doesn't support the state unmarshaling")
+	}
+
+	state, err := marshaler.MarshalBinary()
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal the state: %w", err)
+	}
+
+	if err := unmarshaler.UnmarshalBinary(state); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal the state: %w", err)
+	}
+
+	return clonedRawValue, nil
+}
+
+func makeHashPool(factory func() hash.Hash) *sync.Pool {
+	return &sync.Pool{
+		New: func() any {
+			return factory()
+		},
+	}
+}
+
+func applyRawHashTo(rawValue hash.Hash, data string) HashSum {
+	rawValue.Reset()
+	rawValue.Write([]byte(data))
+	return NewHashSum(rawValue.Sum(nil))
+}
@@ -0,0 +1,211 @@
+package powValueTypes
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"text/template/parse"
+)
+
+type HashDataField string
+
+const (
+	HashDataFieldNonce      HashDataField = "nonce"
+	HashDataFieldDifficulty HashDataField = "difficulty"
+	HashDataFieldCreatedAt  HashDataField = "created-at"
+	HashDataFieldTTL        HashDataField = "ttl"
+	HashDataFieldResource   HashDataField = "resource"
+	HashDataFieldPayload    HashDataField = "payload"
+)
+
+var (
+	allHashDataFields = []HashDataField{
+		HashDataFieldNonce,
+		HashDataFieldDifficulty,
+		HashDataFieldCreatedAt,
+		HashDataFieldTTL,
+		HashDataFieldResource,
+		HashDataFieldPayload,
+	}
+	challengeHashDataFields = map[string]HashDataField{
+		"LeadingZeroBitCount": HashDataFieldDifficulty,
+		"TargetBitIndex":      HashDataFieldDifficulty,
+		"Target":              HashDataFieldDifficulty,
+		"ArbitraryTarget":     HashDataFieldDifficulty,
+		"CreatedAt":           HashDataFieldCreatedAt,
+		"TTL":                 HashDataFieldTTL,
+		"Resource":            HashDataFieldResource,
+		"SerializedPayload":   HashDataFieldPayload,
+	}
+)
+
+func ParseHashDataField(rawValue string) (HashDataField, error) {
+	value := HashDataField(rawValue)
+	if !slices.Contains(allHashDataFields, value) {
+		return "", fmt.Errorf("unknown hash data field %q", rawValue)
+	}
+
+	return value, nil
+}
+
+// the fields are detected statically, so the check is conservative:
+// the dot and variables (except for `$`) are considered to refer to all
+// the fields, as well as everything inside blocks that rebind the dot;
+// the whole challenge is considered to refer to all its fields
+func (value HashDataLayout) ReferencedFields() []HashDataField {
+	fields := make(map[HashDataField]struct{})
+	if binaryRawValue, isBinary := value.binaryRawValue.Get(); isBinary {
+		for _, field := range binaryRawValue.fields {
+			if hashDataField, isPresent := field.hashDataField().Get(); isPresent {
+				fields[hashDataField] = struct{}{}
+			}
+		}
+	} else {
+		if value.rawValue.Tree != nil {
+			collectHashDataFields(value.rawValue.Tree.Root, true, fields)
+		}
+
+		// the dot of an associated template is its argument, so it's unknown
+		for _, rawTemplate := range value.rawValue.Templates() {
+			if rawTemplate == value.rawValue || rawTemplate.Tree == nil {
+				continue
+			}
+
+			collectHashDataFields(rawTemplate.Tree.Root, false, fields)
+		}
+	}
+
+	var referencedFields []HashDataField
+	for _, field := range allHashDataFields {
+		if _, isReferenced := fields[field]; isReferenced {
+			referencedFields = append(referencedFields, field)
+		}
+	}
+
+	return referencedFields
+}
+
+func (value HashDataLayout) CheckRequiredFields(
+	requiredFields []HashDataField,
+) error {
+	referencedFields := value.ReferencedFields()
+
+	var errs []error
+	for _, requiredField := range requiredFields {
+		if !slices.Contains(referencedFields, requiredField) {
+			errs = append(
+				errs,
+				fmt.Errorf("required field %q isn't referenced", requiredField),
+			)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func collectHashDataFields(
+	node parse.Node,
+	isDotKnown bool,
+	fields map[HashDataField]struct{},
+) {
+	switch node := node.(type) {
+	case *parse.FieldNode:
+		if !isDotKnown {
+			addAllHashDataFields(fields)
+			return
+		}
+
+		addHashDataFieldsByPath(node.Ident, fields)
+
+	case *parse.VariableNode:
+		if node.Ident[0] != "$" {
+			addAllHashDataFields(fields)
+			return
+		}
+
+		addHashDataFieldsByPath(node.Ident[1:], fields)
+
+	case *parse.DotNode:
+		addAllHashDataFields(fields)
+
+	case *parse.ListNode:
+		if node == nil {
+			return
+		}
+
+		for _, childNode := range node.Nodes {
+			collectHashDataFields(childNode, isDotKnown, fields)
+		}
+
+	case *parse.ActionNode:
+		collectHashDataFields(node.Pipe, isDotKnown, fields)
+
+	case *parse.TemplateNode:
+		collectHashDataFields(node.Pipe, isDotKnown, fields)
+
+	case *parse.PipeNode:
+		if node == nil {
+			return
+		}
+
+		for _, commandNode := range node.Cmds {
+			collectHashDataFields(commandNode, isDotKnown, fields)
+		}
+
+	case *parse.CommandNode:
+		for _, argumentNode := range node.Args {
+			collectHashDataFields(argumentNode, isDotKnown, fields)
+		}
+
+	case *parse.ChainNode:
+		collectHashDataFields(node.Node, isDotKnown, fields)
+
+	case *parse.IfNode:
+		collectHashDataFields(node.Pipe, isDotKnown, fields)
+		collectHashDataFields(node.List, isDotKnown, fields)
+		collectHashDataFields(node.ElseList, isDotKnown, fields)
+
+	// the dot is rebound inside these blocks, except for their else branches
+	case *parse.RangeNode:
+		collectHashDataFields(node.Pipe, isDotKnown, fields)
+		collectHashDataFields(node.List, false, fields)
+		collectHashDataFields(node.ElseList, isDotKnown, fields)
+
+	case *parse.WithNode:
+		collectHashDataFields(node.Pipe, isDotKnown, fields)
+		collectHashDataFields(node.List, false, fields)
+		collectHashDataFields(node.ElseList, isDotKnown, fields)
+	}
+}
+
+func addHashDataFieldsByPath(
+	path []string,
+	fields map[HashDataField]struct{},
+) {
+	switch {
+	case len(path) == 0:
+		addAllHashDataFields(fields)
+
+	case path[0] == "Nonce":
+		fields[HashDataFieldNonce] = struct{}{}
+
+	case path[0] == "Challenge":
+		if len(path) == 1 {
+			for _, field := range challengeHashDataFields {
+				fields[field] = struct{}{}
+			}
+
+			return
+		}
+
+		if field, isKnown := challengeHashDataFields[path[1]]; isKnown {
+			fields[field] = struct{}{}
+		}
+	}
+}
+
+func addAllHashDataFields(fields map[HashDataField]struct{}) {
+	for _, field := range allHashDataFields {
+		fields[field] = struct{}{}
+	}
+}
@@ -0,0 +1,246 @@
+package powValueTypes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseHashDataField(test *testing.T) {
+	type args struct {
+		rawValue string
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		want    HashDataField
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				rawValue: "created-at",
+			},
+			want:    HashDataFieldCreatedAt,
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error",
+			args: args{
+				rawValue: "dummy",
+			},
+			want:    "",
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err := ParseHashDataField(data.args.rawValue)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestHashDataLayout_ReferencedFields(test *testing.T) {
+	for _, data := range []struct {
+		name  string
+		value HashDataLayout
+		want  []HashDataField
+	}{
+		{
+			name: "success/text/typical layout",
+			value: MustParseHashDataLayout(
+				"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+					":{{ .Challenge.SerializedPayload.ToString }}" +
+					":{{ .Nonce.ToString }}",
+			),
+			want: []HashDataField{
+				HashDataFieldNonce,
+				HashDataFieldDifficulty,
+				HashDataFieldPayload,
+			},
+		},
+		{
+			name:  "success/text/without the nonce",
+			value: MustParseHashDataLayout("{{ .Challenge.SerializedPayload }}"),
+			want:  []HashDataField{HashDataFieldPayload},
+		},
+		{
+			name:  "success/text/without fields",
+			value: MustParseHashDataLayout("dummy"),
+			want:  nil,
+		},
+		{
+			name: "success/text/all the challenge fields",
+			value: MustParseHashDataLayout(
+				"{{ .Challenge.Target.ToString }}" +
+					"{{ .Challenge.CreatedAt.OrEmpty.ToString }}" +
+					"{{ .Challenge.TTL.OrEmpty.ToString }}" +
+					"{{ if .Challenge.Resource.IsPresent }}" +
+					"{{ .Challenge.Resource.MustGet.ToString }}" +
+					"{{ end }}",
+			),
+			want: []HashDataField{
+				HashDataFieldDifficulty,
+				HashDataFieldCreatedAt,
+				HashDataFieldTTL,
+				HashDataFieldResource,
+			},
+		},
+		{
+			name:  "success/text/function arguments",
+			value: MustParseHashDataLayout("{{ padNonce 20 .Nonce }}"),
+			want:  []HashDataField{HashDataFieldNonce},
+		},
+		{
+			name:  "success/text/pipeline",
+			value: MustParseHashDataLayout("{{ .Nonce | padNonce 20 }}"),
+			want:  []HashDataField{HashDataFieldNonce},
+		},
+		{
+			name:  "success/text/root variable",
+			value: MustParseHashDataLayout("{{ $.Nonce.ToString }}"),
+			want:  []HashDataField{HashDataFieldNonce},
+		},
+		{
+			name:  "success/text/unrelated challenge fields",
+			value: MustParseHashDataLayout("{{ .Challenge.Hash.Name }}"),
+			want:  nil,
+		},
+		{
+			name:  "success/text/whole challenge",
+			value: MustParseHashDataLayout("{{ .Challenge }}"),
+			want: []HashDataField{
+				HashDataFieldDifficulty,
+				HashDataFieldCreatedAt,
+				HashDataFieldTTL,
+				HashDataFieldResource,
+				HashDataFieldPayload,
+			},
+		},
+		{
+			name:  "success/text/dot",
+			value: MustParseHashDataLayout("{{ . }}"),
+			want:  allHashDataFields,
+		},
+		{
+			name: "success/text/variable",
+			value: MustParseHashDataLayout(
+				"{{ $nonce := .Nonce }}{{ $nonce.ToString }}",
+			),
+			want: allHashDataFields,
+		},
+		{
+			name: "success/text/dot rebinding",
+			value: MustParseHashDataLayout(
+				"{{ with .Challenge.SerializedPayload }}{{ .ToString }}{{ end }}",
+			),
+			want: allHashDataFields,
+		},
+		{
+			name: "success/text/else branch of dot rebinding",
+			value: MustParseHashDataLayout(
+				"{{ with .Challenge.Resource.OrEmpty.ToURL }}" +
+					"{{ $.Nonce.ToString }}" +
+					"{{ else }}" +
+					"{{ .Nonce.ToString }}" +
+					"{{ end }}",
+			),
+			want: []HashDataField{HashDataFieldNonce, HashDataFieldResource},
+		},
+		{
+			name: "success/text/associated template",
+			value: MustParseHashDataLayout(
+				`{{ define "nonce" }}{{ .ToString }}{{ end }}` +
+					`{{ template "nonce" .Nonce }}`,
+			),
+			want: allHashDataFields,
+		},
+		{
+			name: "success/binary",
+			value: NewBinaryHashDataLayout(MustParseBinaryHashDataLayout(
+				"literal:01,target,payload,nonce:8",
+			)),
+			want: []HashDataField{
+				HashDataFieldNonce,
+				HashDataFieldDifficulty,
+				HashDataFieldPayload,
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got := data.value.ReferencedFields()
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
+func TestHashDataLayout_CheckRequiredFields(test *testing.T) {
+	type args struct {
+		requiredFields []HashDataField
+	}
+
+	for _, data := range []struct {
+		name    string
+		value   HashDataLayout
+		args    args
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/all the fields are referenced",
+			value: MustParseHashDataLayout(
+				"{{ .Challenge.SerializedPayload.ToString }}:{{ .Nonce.ToString }}",
+			),
+			args: args{
+				requiredFields: []HashDataField{
+					HashDataFieldNonce,
+					HashDataFieldPayload,
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name:  "success/without required fields",
+			value: MustParseHashDataLayout("dummy"),
+			args: args{
+				requiredFields: nil,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/text",
+			value: MustParseHashDataLayout(
+				"{{ .Challenge.SerializedPayload.ToString }}",
+			),
+			args: args{
+				requiredFields: []HashDataField{
+					HashDataFieldNonce,
+					HashDataFieldPayload,
+				},
+			},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/binary",
+			value: NewBinaryHashDataLayout(
+				MustParseBinaryHashDataLayout("payload,nonce:8"),
+			),
+			args: args{
+				requiredFields: []HashDataField{
+					HashDataFieldNonce,
+					HashDataFieldDifficulty,
+				},
+			},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			err := data.value.CheckRequiredFields(data.args.requiredFields)
+
+			data.wantErr(test, err)
+		})
+	}
+}
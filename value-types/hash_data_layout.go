@@ -2,12 +2,25 @@ package powValueTypes
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
+	"slices"
 	"text/template"
+	"text/template/parse"
+
+	"github.com/samber/mo"
+)
+
+type HashDataLayoutKind string
+
+const (
+	HashDataLayoutKindText   HashDataLayoutKind = "text"
+	HashDataLayoutKindBinary HashDataLayoutKind = "binary"
 )
 
 type HashDataLayout struct {
-	rawValue *template.Template
+	rawValue       *template.Template
+	binaryRawValue mo.Option[BinaryHashDataLayout]
 }
 
 func NewHashDataLayout(rawValue *template.Template) HashDataLayout {
@@ -17,7 +30,9 @@ func NewHashDataLayout(rawValue *template.Template) HashDataLayout {
 }
 
 func ParseHashDataLayout(rawValue string) (HashDataLayout, error) {
-	parsedRawValue, err := template.New("").Parse(rawValue)
+	parsedRawValue, err := template.New("").
+		Funcs(HashDataLayoutFuncs()).
+		Parse(rawValue)
 	if err != nil {
 		return HashDataLayout{}, fmt.Errorf(
 			"unable to parse the text template: %w",
@@ -28,6 +43,35 @@ func ParseHashDataLayout(rawValue string) (HashDataLayout, error) {
 	return NewHashDataLayout(parsedRawValue), nil
 }
 
+func NewBinaryHashDataLayout(rawValue BinaryHashDataLayout) HashDataLayout {
+	return HashDataLayout{
+		binaryRawValue: mo.Some(rawValue),
+	}
+}
+
+func ParseHashDataLayoutOfKind(
+	kind HashDataLayoutKind,
+	rawValue string,
+) (HashDataLayout, error) {
+	switch kind {
+	case HashDataLayoutKindText:
+		return ParseHashDataLayout(rawValue)
+
+	case HashDataLayoutKindBinary:
+		binaryRawValue, err := ParseBinaryHashDataLayout(rawValue)
+		if err != nil {
+			return HashDataLayout{}, fmt.Errorf(
+				"unable to parse the binary layout: %w",
+				err,
+			)
+		}
+
+		return NewBinaryHashDataLayout(binaryRawValue), nil
+	}
+
+	return HashDataLayout{}, fmt.Errorf("unknown hash data layout kind %q", kind)
+}
+
 func MustParseHashDataLayout(rawValue string) HashDataLayout {
 	value, err := ParseHashDataLayout(rawValue)
 	if err != nil {
@@ -37,7 +81,36 @@ func MustParseHashDataLayout(rawValue string) HashDataLayout {
 	return value
 }
 
+func (value HashDataLayout) Kind() HashDataLayoutKind {
+	if value.binaryRawValue.IsPresent() {
+		return HashDataLayoutKindBinary
+	}
+
+	return HashDataLayoutKindText
+}
+
+// the raw bytes of a binary layout are returned as a string as well,
+// so they are hashed in the same way as the text
 func (value HashDataLayout) Execute(data any) (string, error) {
+	if binaryRawValue, isBinary := value.binaryRawValue.Get(); isBinary {
+		source, isSource := data.(BinaryHashDataSource)
+		if !isSource {
+			return "", errors.New("data isn't a source of binary hash data")
+		}
+
+		binaryData, err := source.ToBinaryHashData()
+		if err != nil {
+			return "", fmt.Errorf("unable to get the binary hash data: %w", err)
+		}
+
+		rawData, err := binaryRawValue.Execute(binaryData)
+		if err != nil {
+			return "", fmt.Errorf("unable to execute the binary layout: %w", err)
+		}
+
+		return string(rawData), nil
+	}
+
 	var buffer bytes.Buffer
 	if err := value.rawValue.Execute(&buffer, data); err != nil {
 		return "", fmt.Errorf("unable to execute the text template: %w", err)
@@ -46,10 +119,179 @@ func (value HashDataLayout) Execute(data any) (string, error) {
 	return buffer.String(), nil
 }
 
+// it's nil for a binary layout
 func (value HashDataLayout) ToTemplate() *template.Template {
 	return value.rawValue
 }
 
+func (value HashDataLayout) ToBinary() mo.Option[BinaryHashDataLayout] {
+	return value.binaryRawValue
+}
+
 func (value HashDataLayout) ToString() string {
+	if binaryRawValue, isBinary := value.binaryRawValue.Get(); isBinary {
+		return binaryRawValue.ToString()
+	}
+
 	return value.rawValue.Root.String()
 }
+
+type SplitHashDataLayout struct {
+	Prefix HashDataLayout
+	Suffix HashDataLayout
+}
+
+// the layout can be split only if it contains a single top-level
+// `{{ .Nonce.ToString }}` action and the rest of it doesn't depend
+// on the nonce in any way
+func (value HashDataLayout) SplitByNonce() (SplitHashDataLayout, bool) {
+	if value.binaryRawValue.IsPresent() {
+		return SplitHashDataLayout{}, false
+	}
+
+	nodes := value.rawValue.Root.Nodes
+	nonceNodeIndex := -1
+	for nodeIndex, node := range nodes {
+		if isNonceActionNode(node) {
+			if nonceNodeIndex != -1 {
+				return SplitHashDataLayout{}, false
+			}
+
+			nonceNodeIndex = nodeIndex
+			continue
+		}
+
+		if mayDependOnNonce(node) {
+			return SplitHashDataLayout{}, false
+		}
+	}
+	if nonceNodeIndex == -1 {
+		return SplitHashDataLayout{}, false
+	}
+
+	prefix, err := value.withNodes(nodes[:nonceNodeIndex])
+	if err != nil {
+		return SplitHashDataLayout{}, false
+	}
+
+	suffix, err := value.withNodes(nodes[nonceNodeIndex+1:])
+	if err != nil {
+		return SplitHashDataLayout{}, false
+	}
+
+	splitValue := SplitHashDataLayout{
+		Prefix: prefix,
+		Suffix: suffix,
+	}
+	return splitValue, true
+}
+
+func (value HashDataLayout) withNodes(
+	nodes []parse.Node,
+) (HashDataLayout, error) {
+	tree := value.rawValue.Tree.Copy()
+	tree.Root.Nodes = nil
+	for _, node := range nodes {
+		tree.Root.Nodes = append(tree.Root.Nodes, node.Copy())
+	}
+
+	// the clone keeps the functions of the original template
+	clonedRawValue, err := value.rawValue.Clone()
+	if err != nil {
+		return HashDataLayout{}, fmt.Errorf(
+			"unable to clone the text template: %w",
+			err,
+		)
+	}
+
+	// a separate name is required, because an existing template
+	// isn't replaced with an empty one
+	rawValue, err :=
+		clonedRawValue.AddParseTree(clonedRawValue.Name()+"#part", tree)
+	if err != nil {
+		return HashDataLayout{}, fmt.Errorf("unable to add the parse tree: %w", err)
+	}
+
+	return NewHashDataLayout(rawValue), nil
+}
+
+func isNonceActionNode(node parse.Node) bool {
+	actionNode, isActionNode := node.(*parse.ActionNode)
+	if !isActionNode ||
+		len(actionNode.Pipe.Decl) != 0 ||
+		len(actionNode.Pipe.Cmds) != 1 ||
+		len(actionNode.Pipe.Cmds[0].Args) != 1 {
+		return false
+	}
+
+	fieldNode, isFieldNode := actionNode.Pipe.Cmds[0].Args[0].(*parse.FieldNode)
+	return isFieldNode &&
+		slices.Equal(fieldNode.Ident, []string{"Nonce", "ToString"})
+}
+
+// the check is conservative: everything that is able to reach the nonce
+// (including the dot itself and variables) is considered dependent on it
+func mayDependOnNonce(node parse.Node) bool {
+	switch node := node.(type) {
+	case nil:
+		return false
+
+	case *parse.TextNode, *parse.CommentNode, *parse.IdentifierNode,
+		*parse.StringNode, *parse.NumberNode, *parse.BoolNode, *parse.NilNode,
+		*parse.BreakNode, *parse.ContinueNode:
+		return false
+
+	case *parse.FieldNode:
+		return len(node.Ident) == 0 || node.Ident[0] == "Nonce"
+
+	case *parse.ListNode:
+		if node == nil {
+			return false
+		}
+
+		return slices.ContainsFunc(node.Nodes, mayDependOnNonce)
+
+	case *parse.ActionNode:
+		return mayDependOnNonce(node.Pipe)
+
+	case *parse.PipeNode:
+		if node == nil {
+			return false
+		}
+		if len(node.Decl) != 0 {
+			return true
+		}
+
+		for _, commandNode := range node.Cmds {
+			if mayDependOnNonce(commandNode) {
+				return true
+			}
+		}
+
+		return false
+
+	case *parse.CommandNode:
+		return slices.ContainsFunc(node.Args, mayDependOnNonce)
+
+	case *parse.ChainNode:
+		return mayDependOnNonce(node.Node)
+
+	case *parse.IfNode:
+		return mayDependOnBranch(node.BranchNode)
+
+	case *parse.RangeNode:
+		return mayDependOnBranch(node.BranchNode)
+
+	case *parse.WithNode:
+		return mayDependOnBranch(node.BranchNode)
+
+	default: // including the dot, variables and template calls
+		return true
+	}
+}
+
+func mayDependOnBranch(node parse.BranchNode) bool {
+	return mayDependOnNonce(node.Pipe) ||
+		mayDependOnNonce(node.List) ||
+		mayDependOnNonce(node.ElseList)
+}
@@ -0,0 +1,78 @@
+package powValueTypes
+
+import (
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+const (
+	MaxPaddedNonceWidth = 1024
+)
+
+// all the functions are deterministic, so a layout always gives
+// the same hash input for the same data:
+//   - `hex`, `base64` and `base64url` encode a string (`base64url` omits
+//     the padding);
+//   - `lengthPrefix` prefixes a string with its length in bytes
+//     and a colon (for example, `5:dummy`);
+//   - `padNonce` renders a nonce as a decimal number padded with zeros
+//     to the specified width (for example, `{{ padNonce 20 .Nonce }}`)
+//     regardless of the nonce encoding;
+//   - `unix` converts a time to the Unix time in seconds;
+//   - `pathEscape` and `queryEscape` escape a string for a URL path segment
+//     and a URL query respectively
+func HashDataLayoutFuncs() template.FuncMap {
+	return template.FuncMap{
+		"hex":          encodeHex,
+		"base64":       encodeBase64,
+		"base64url":    encodeBase64URL,
+		"lengthPrefix": prefixWithLength,
+		"padNonce":     padNonce,
+		"unix":         toUnixTime,
+		"pathEscape":   url.PathEscape,
+		"queryEscape":  url.QueryEscape,
+	}
+}
+
+func encodeHex(value string) string {
+	return hex.EncodeToString([]byte(value))
+}
+
+func encodeBase64(value string) string {
+	return base64.StdEncoding.EncodeToString([]byte(value))
+}
+
+func encodeBase64URL(value string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(value))
+}
+
+func prefixWithLength(value string) string {
+	return strconv.Itoa(len(value)) + ":" + value
+}
+
+func padNonce(width int, nonce Nonce) (string, error) {
+	if width < 0 || width > MaxPaddedNonceWidth {
+		return "", fmt.Errorf(
+			"nonce width must be in the range [0, %d]",
+			MaxPaddedNonceWidth,
+		)
+	}
+
+	rawNonce := DefaultNonceEncoding.Encode(nonce)
+	if len(rawNonce) > width {
+		return "", errors.New("nonce exceeds the width")
+	}
+
+	return strings.Repeat("0", width-len(rawNonce)) + rawNonce, nil
+}
+
+func toUnixTime(moment time.Time) int64 {
+	return moment.Unix()
+}
@@ -0,0 +1,216 @@
+package powValueTypes
+
+import (
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHashDataLayoutFuncs(test *testing.T) {
+	type hashData struct {
+		Payload string
+		Nonce   Nonce
+		Moment  time.Time
+	}
+	type args struct {
+		data hashData
+	}
+
+	for _, data := range []struct {
+		name     string
+		rawValue string
+		args     args
+		want     string
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name:     "success/hex",
+			rawValue: "{{ hex .Payload }}",
+			args: args{
+				data: hashData{Payload: "a:b"},
+			},
+			want:    "613a62",
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "success/base64",
+			rawValue: "{{ base64 .Payload }}",
+			args: args{
+				data: hashData{Payload: "a?b>"},
+			},
+			want:    "YT9iPg==",
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "success/base64url",
+			rawValue: "{{ base64url .Payload }}",
+			args: args{
+				data: hashData{Payload: "a?b>"},
+			},
+			want:    "YT9iPg",
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "success/length prefix",
+			rawValue: "{{ lengthPrefix .Payload }}:{{ .Nonce.ToString }}",
+			args: args{
+				data: hashData{Payload: "a:b", Nonce: Nonce{rawValue: big.NewInt(23)}},
+			},
+			want:    "3:a:b:23",
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "success/length prefix/empty",
+			rawValue: "{{ lengthPrefix .Payload }}",
+			args: args{
+				data: hashData{Payload: ""},
+			},
+			want:    "0:",
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "success/padded nonce",
+			rawValue: "{{ padNonce 5 .Nonce }}",
+			args: args{
+				data: hashData{Nonce: Nonce{rawValue: big.NewInt(23)}},
+			},
+			want:    "00023",
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "success/padded nonce/in a pipeline",
+			rawValue: "{{ .Nonce | padNonce 2 }}",
+			args: args{
+				data: hashData{Nonce: Nonce{rawValue: big.NewInt(23)}},
+			},
+			want:    "23",
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "success/Unix time",
+			rawValue: "{{ unix .Moment }}",
+			args: args{
+				data: hashData{
+					Moment: time.Date(2000, time.January, 2, 3, 4, 5, 6, time.UTC),
+				},
+			},
+			want:    "946782245",
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "success/path escaping",
+			rawValue: "{{ pathEscape .Payload }}",
+			args: args{
+				data: hashData{Payload: "a b/c?"},
+			},
+			want:    "a%20b%2Fc%3F",
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "success/query escaping",
+			rawValue: "{{ queryEscape .Payload }}",
+			args: args{
+				data: hashData{Payload: "a b&c"},
+			},
+			want:    "a+b%26c",
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "success/inside a branch",
+			rawValue: "{{ if .Payload }}{{ hex .Payload }}{{ else }}-{{ end }}",
+			args: args{
+				data: hashData{Payload: "a"},
+			},
+			want:    "61",
+			wantErr: assert.NoError,
+		},
+		{
+			name:     "error/nonce exceeds the width",
+			rawValue: "{{ padNonce 1 .Nonce }}",
+			args: args{
+				data: hashData{Nonce: Nonce{rawValue: big.NewInt(23)}},
+			},
+			want:    "",
+			wantErr: assert.Error,
+		},
+		{
+			name:     "error/too large nonce width",
+			rawValue: "{{ padNonce 1025 .Nonce }}",
+			args: args{
+				data: hashData{Nonce: Nonce{rawValue: big.NewInt(23)}},
+			},
+			want:    "",
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			value, err := ParseHashDataLayout(data.rawValue)
+			require.NoError(test, err)
+
+			got, err := value.Execute(data.args.data)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestHashDataLayoutFuncs_withNonceOfLargeWidth(test *testing.T) {
+	rawNonce, isParsed :=
+		big.NewInt(0).SetString("123456789012345678901234567890", 10)
+	require.True(test, isParsed)
+
+	nonce, err := NewNonce(rawNonce)
+	require.NoError(test, err)
+
+	value, err := ParseHashDataLayout("{{ padNonce 32 .Nonce }}")
+	require.NoError(test, err)
+
+	got, err := value.Execute(struct{ Nonce Nonce }{Nonce: nonce})
+	require.NoError(test, err)
+
+	assert.Equal(test, "00123456789012345678901234567890", got)
+}
+
+func TestParseHashDataLayout_withFuncs(test *testing.T) {
+	for _, data := range []struct {
+		name     string
+		rawValue string
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name:     "success/without functions",
+			rawValue: "{{ .Payload }}",
+			wantErr:  assert.NoError,
+		},
+		{
+			name:     "success/built-in function",
+			rawValue: "{{ hex .Payload }}",
+			wantErr:  assert.NoError,
+		},
+		{
+			name:     "success/function in a definition",
+			rawValue: `{{ define "part" }}{{ hex . }}{{ end }}{{ template "part" .Payload }}`, //nolint:lll
+			wantErr:  assert.NoError,
+		},
+		{
+			name:     "error/unknown function",
+			rawValue: "{{ dummy .Payload }}",
+			wantErr:  assert.Error,
+		},
+		{
+			name:     "error/invalid syntax",
+			rawValue: "{{ hex .Payload",
+			wantErr:  assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			_, err := ParseHashDataLayout(data.rawValue)
+
+			data.wantErr(test, err)
+		})
+	}
+}
@@ -1,10 +1,14 @@
 package powValueTypes
 
 import (
+	"errors"
+	"math/big"
 	"testing"
 	"text/template"
 
+	"github.com/samber/mo"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewHashDataLayout(test *testing.T) {
@@ -68,7 +72,100 @@ func TestParseHashDataLayout(test *testing.T) {
 		test.Run(data.name, func(test *testing.T) {
 			got, err := ParseHashDataLayout(data.args.rawValue)
 
-			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+			if err == nil {
+				// the parsed templates carry the layout functions,
+				// which cannot be compared
+				assert.Equal(test, data.want.ToString(), got.ToString())
+				assert.Equal(test, data.want.Kind(), got.Kind())
+			} else {
+				assert.Equal(test, data.want, got)
+			}
+		})
+	}
+}
+
+func TestNewBinaryHashDataLayout(test *testing.T) {
+	got := NewBinaryHashDataLayout(MustParseBinaryHashDataLayout("payload"))
+
+	assert.Equal(test, HashDataLayoutKindBinary, got.Kind())
+	assert.Nil(test, got.ToTemplate())
+	assert.Equal(
+		test,
+		mo.Some(MustParseBinaryHashDataLayout("payload")),
+		got.ToBinary(),
+	)
+}
+
+func TestParseHashDataLayoutOfKind(test *testing.T) {
+	type args struct {
+		kind     HashDataLayoutKind
+		rawValue string
+	}
+
+	for _, data := range []struct {
+		name         string
+		args         args
+		wantKind     HashDataLayoutKind
+		wantToString string
+		wantErr      assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/text",
+			args: args{
+				kind:     HashDataLayoutKindText,
+				rawValue: "dummy {{ .Dummy }}",
+			},
+			wantKind:     HashDataLayoutKindText,
+			wantToString: "dummy {{.Dummy}}",
+			wantErr:      assert.NoError,
+		},
+		{
+			name: "success/binary",
+			args: args{
+				kind:     HashDataLayoutKindBinary,
+				rawValue: "payload, nonce:8",
+			},
+			wantKind:     HashDataLayoutKindBinary,
+			wantToString: "payload,nonce:8",
+			wantErr:      assert.NoError,
+		},
+		{
+			name: "error/text",
+			args: args{
+				kind:     HashDataLayoutKindText,
+				rawValue: "dummy {{ .Dummy",
+			},
+			wantKind: HashDataLayoutKindText,
+			wantErr:  assert.Error,
+		},
+		{
+			name: "error/binary",
+			args: args{
+				kind:     HashDataLayoutKindBinary,
+				rawValue: "{{ .Nonce.ToString }}",
+			},
+			wantKind: HashDataLayoutKindText,
+			wantErr:  assert.Error,
+		},
+		{
+			name: "error/unknown kind",
+			args: args{
+				kind:     "dummy",
+				rawValue: "dummy {{ .Dummy }}",
+			},
+			wantKind: HashDataLayoutKindText,
+			wantErr:  assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err :=
+				ParseHashDataLayoutOfKind(data.args.kind, data.args.rawValue)
+
+			assert.Equal(test, data.wantKind, got.Kind())
+			if data.wantToString != "" {
+				assert.Equal(test, data.wantToString, got.ToString())
+			}
 			data.wantErr(test, err)
 		})
 	}
@@ -110,7 +207,12 @@ func TestMustParseHashDataLayout(test *testing.T) {
 				got = MustParseHashDataLayout(data.args.rawValue)
 			})
 
-			assert.Equal(test, data.want, got)
+			if data.want.ToTemplate() != nil {
+				assert.Equal(test, data.want.ToString(), got.ToString())
+				assert.Equal(test, data.want.Kind(), got.Kind())
+			} else {
+				assert.Equal(test, data.want, got)
+			}
 		})
 	}
 }
@@ -188,6 +290,86 @@ func TestHashDataLayout_Execute(test *testing.T) {
 	}
 }
 
+type binaryHashDataSourceStub struct {
+	data BinaryHashData
+	err  error
+}
+
+func (stub binaryHashDataSourceStub) ToBinaryHashData() (
+	BinaryHashData,
+	error,
+) {
+	return stub.data, stub.err
+}
+
+func TestHashDataLayout_Execute_withBinaryLayout(test *testing.T) {
+	type args struct {
+		data any
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		want    string
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				data: binaryHashDataSourceStub{
+					data: BinaryHashData{
+						SerializedPayload: SerializedPayload{rawValue: "a"},
+						Nonce:             Nonce{rawValue: big.NewInt(23)},
+					},
+				},
+			},
+			want:    "\x00\x00\x00\x01a\x00\x17",
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/data isn't a source of binary hash data",
+			args: args{
+				data: struct{}{},
+			},
+			want:    "",
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/unable to get the binary hash data",
+			args: args{
+				data: binaryHashDataSourceStub{
+					err: errors.New("dummy"),
+				},
+			},
+			want:    "",
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/unable to execute the binary layout",
+			args: args{
+				data: binaryHashDataSourceStub{
+					data: BinaryHashData{
+						SerializedPayload: SerializedPayload{rawValue: "a"},
+						Nonce:             Nonce{rawValue: big.NewInt(0x010000)},
+					},
+				},
+			},
+			want:    "",
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			value := NewBinaryHashDataLayout(
+				MustParseBinaryHashDataLayout("payload,nonce:2"),
+			)
+			got, err := value.Execute(data.args.data)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
+
 func TestHashDataLayout_ToTemplate(test *testing.T) {
 	type fields struct {
 		rawValue *template.Template
@@ -245,3 +427,157 @@ func TestHashDataLayout_ToString(test *testing.T) {
 		})
 	}
 }
+
+func TestHashDataLayout_SplitByNonce(test *testing.T) {
+	type fields struct {
+		rawValue *template.Template
+	}
+
+	for _, data := range []struct {
+		name       string
+		fields     fields
+		wantPrefix string
+		wantSuffix string
+		wantOk     bool
+	}{
+		{
+			name: "success/nonce in the middle",
+			fields: fields{
+				rawValue: template.Must(template.New("").Parse(
+					"{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+						":{{ .Nonce.ToString }}" +
+						":{{ .Challenge.SerializedPayload.ToString }}",
+				)),
+			},
+			wantPrefix: "{{.Challenge.LeadingZeroBitCount.ToInt}}:",
+			wantSuffix: ":{{.Challenge.SerializedPayload.ToString}}",
+			wantOk:     true,
+		},
+		{
+			name: "success/nonce at the end",
+			fields: fields{
+				rawValue: template.Must(template.New("").Parse(
+					"{{ if .Challenge.Resource.IsPresent }}" +
+						"{{ .Challenge.Resource.MustGet.ToString }}" +
+						"{{ end }}" +
+						":{{ .Nonce.ToString }}",
+				)),
+			},
+			wantPrefix: "{{if .Challenge.Resource.IsPresent}}" +
+				"{{.Challenge.Resource.MustGet.ToString}}" +
+				"{{end}}:",
+			wantSuffix: "",
+			wantOk:     true,
+		},
+		{
+			name: "success/nonce only",
+			fields: fields{
+				rawValue: template.Must(template.New("").Parse("{{ .Nonce.ToString }}")),
+			},
+			wantPrefix: "",
+			wantSuffix: "",
+			wantOk:     true,
+		},
+		{
+			name: "failure/without the nonce",
+			fields: fields{
+				rawValue: template.Must(template.New("").Parse("dummy {{ .Dummy }}")),
+			},
+			wantOk: false,
+		},
+		{
+			name: "failure/nonce isn't at the top level",
+			fields: fields{
+				rawValue: template.Must(template.New("").Parse(
+					"{{ with .Challenge }}{{ $.Nonce.ToString }}{{ end }}",
+				)),
+			},
+			wantOk: false,
+		},
+		{
+			name: "failure/several nonces",
+			fields: fields{
+				rawValue: template.Must(template.New("").Parse(
+					"{{ .Nonce.ToString }}:{{ .Nonce.ToString }}",
+				)),
+			},
+			wantOk: false,
+		},
+		{
+			name: "failure/nonce is used in another form",
+			fields: fields{
+				rawValue: template.Must(template.New("").Parse(
+					"{{ .Nonce.ToBigInt }}:{{ .Nonce.ToString }}",
+				)),
+			},
+			wantOk: false,
+		},
+		{
+			name: "failure/dot is used",
+			fields: fields{
+				rawValue: template.Must(template.New("").Parse(
+					"{{ printf `%v` . }}:{{ .Nonce.ToString }}",
+				)),
+			},
+			wantOk: false,
+		},
+		{
+			name: "failure/variable is used",
+			fields: fields{
+				rawValue: template.Must(template.New("").Parse(
+					"{{ $nonce := .Nonce }}{{ .Nonce.ToString }}",
+				)),
+			},
+			wantOk: false,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			value := HashDataLayout{
+				rawValue: data.fields.rawValue,
+			}
+			got, gotOk := value.SplitByNonce()
+
+			assert.Equal(test, data.wantOk, gotOk)
+			if data.wantOk {
+				assert.Equal(test, data.wantPrefix, got.Prefix.ToString())
+				assert.Equal(test, data.wantSuffix, got.Suffix.ToString())
+			}
+		})
+	}
+}
+
+func TestHashDataLayout_SplitByNonce_withBinaryLayout(test *testing.T) {
+	value := NewBinaryHashDataLayout(
+		MustParseBinaryHashDataLayout("payload,nonce:8"),
+	)
+
+	_, gotOk := value.SplitByNonce()
+
+	assert.False(test, gotOk)
+}
+
+func TestHashDataLayout_SplitByNonce_withExecution(test *testing.T) {
+	value := MustParseHashDataLayout(
+		"{{ .Prefix }}:{{ .Nonce.ToString }}:{{ .Suffix }}",
+	)
+	// the parts don't contain the nonce, so it isn't required in the data
+	hashData := struct {
+		Prefix string
+		Suffix string
+	}{
+		Prefix: "prefix",
+		Suffix: "suffix",
+	}
+
+	got, gotOk := value.SplitByNonce()
+	require.True(test, gotOk)
+
+	prefix, err := got.Prefix.Execute(hashData)
+	require.NoError(test, err)
+
+	suffix, err := got.Suffix.Execute(hashData)
+	require.NoError(test, err)
+
+	assert.Equal(test, "prefix:", prefix)
+	assert.Equal(test, ":suffix", suffix)
+}
@@ -0,0 +1,112 @@
+package powValueTypes
+
+import (
+	"crypto/md5"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"fmt"
+	"hash"
+	"hash/fnv"
+	"maps"
+	"slices"
+	"sync"
+)
+
+var (
+	DefaultHashRegistry = NewHashRegistry()
+)
+
+func init() {
+	for name, factory := range map[string]func() hash.Hash{
+		"MD5":         md5.New,
+		"SHA-1":       sha1.New,
+		"SHA-224":     sha256.New224,
+		"SHA-256":     sha256.New,
+		"SHA-384":     sha512.New384,
+		"SHA-512":     sha512.New,
+		"SHA-512/224": sha512.New512_224,
+		"SHA-512/256": sha512.New512_256,
+		"FNV-32":      func() hash.Hash { return fnv.New32() },
+		"FNV-32a":     func() hash.Hash { return fnv.New32a() },
+		"FNV-64":      func() hash.Hash { return fnv.New64() },
+		"FNV-64a":     func() hash.Hash { return fnv.New64a() },
+		"FNV-128":     fnv.New128,
+		"FNV-128a":    fnv.New128a,
+	} {
+		if err := DefaultHashRegistry.Register(name, factory); err != nil {
+			panic(fmt.Sprintf("unable to register the hash %q: %s", name, err))
+		}
+	}
+}
+
+type HashRegistry struct {
+	mutex  sync.RWMutex
+	hashes map[string]Hash
+}
+
+func NewHashRegistry() *HashRegistry {
+	return &HashRegistry{
+		hashes: make(map[string]Hash),
+	}
+}
+
+func (registry *HashRegistry) Register(
+	name string,
+	factory func() hash.Hash,
+) error {
+	// the hash is constructed once, so all the challenges that use it
+	// share the same pool of its instances
+	value, err := NewHashFromFactoryWithName(factory, name)
+	if err != nil {
+		return fmt.Errorf("unable to construct the hash: %w", err)
+	}
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	if _, isRegistered := registry.hashes[name]; isRegistered {
+		return fmt.Errorf("hash %q is already registered", name)
+	}
+
+	registry.hashes[name] = value
+	return nil
+}
+
+func (registry *HashRegistry) Lookup(name string) (Hash, error) {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	value, isRegistered := registry.hashes[name]
+	if !isRegistered {
+		return Hash{}, fmt.Errorf("hash %q isn't registered", name)
+	}
+
+	return value, nil
+}
+
+func (registry *HashRegistry) Names() []string {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	return slices.Sorted(maps.Keys(registry.hashes))
+}
+
+func (registry *HashRegistry) Restricted(
+	allowedNames ...string,
+) (*HashRegistry, error) {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	restrictedRegistry := NewHashRegistry()
+	for _, name := range allowedNames {
+		value, isRegistered := registry.hashes[name]
+		if !isRegistered {
+			return nil, fmt.Errorf("hash %q isn't registered", name)
+		}
+
+		restrictedRegistry.hashes[name] = value
+	}
+
+	return restrictedRegistry, nil
+}
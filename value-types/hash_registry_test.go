@@ -0,0 +1,230 @@
+package powValueTypes
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"hash"
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewHashRegistry(test *testing.T) {
+	got := NewHashRegistry()
+
+	assert.Empty(test, got.hashes)
+}
+
+func TestHashRegistry_Register(test *testing.T) {
+	type fields struct {
+		names []string
+	}
+	type args struct {
+		name    string
+		factory func() hash.Hash
+	}
+
+	for _, data := range []struct {
+		name      string
+		fields    fields
+		args      args
+		wantNames []string
+		wantErr   assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			fields: fields{
+				names: []string{"SHA-512"},
+			},
+			args: args{
+				name:    "SHA-256",
+				factory: sha256.New,
+			},
+			wantNames: []string{"SHA-256", "SHA-512"},
+			wantErr:   assert.NoError,
+		},
+		{
+			name: "error/empty name",
+			fields: fields{
+				names: []string{"SHA-512"},
+			},
+			args: args{
+				name:    "",
+				factory: sha256.New,
+			},
+			wantNames: []string{"SHA-512"},
+			wantErr:   assert.Error,
+		},
+		{
+			name: "error/already registered",
+			fields: fields{
+				names: []string{"SHA-256", "SHA-512"},
+			},
+			args: args{
+				name:    "SHA-256",
+				factory: sha256.New,
+			},
+			wantNames: []string{"SHA-256", "SHA-512"},
+			wantErr:   assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			registry := NewHashRegistry()
+			for _, name := range data.fields.names {
+				err := registry.Register(name, sha512.New)
+				require.NoError(test, err)
+			}
+
+			err := registry.Register(data.args.name, data.args.factory)
+
+			gotNames := slices.Collect(maps.Keys(registry.hashes))
+			assert.ElementsMatch(test, data.wantNames, gotNames)
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestHashRegistry_Lookup(test *testing.T) {
+	type args struct {
+		name string
+	}
+
+	for _, data := range []struct {
+		name         string
+		args         args
+		wantName     string
+		wantHashSize int
+		wantErr      assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				name: "SHA-256",
+			},
+			wantName:     "SHA-256",
+			wantHashSize: 32,
+			wantErr:      assert.NoError,
+		},
+		{
+			name: "error",
+			args: args{
+				name: "dummy",
+			},
+			wantName:     "",
+			wantHashSize: 0,
+			wantErr:      assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			registry := NewHashRegistry()
+			err := registry.Register("SHA-256", sha256.New)
+			require.NoError(test, err)
+
+			got, err := registry.Lookup(data.args.name)
+
+			if data.wantName != "" {
+				assert.Equal(test, data.wantName, got.Name())
+				assert.Equal(test, data.wantHashSize, got.SizeInBytes())
+			}
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestHashRegistry_Names(test *testing.T) {
+	registry := NewHashRegistry()
+	for _, name := range []string{"SHA-512", "SHA-256"} {
+		err := registry.Register(name, sha256.New)
+		require.NoError(test, err)
+	}
+
+	got := registry.Names()
+
+	assert.Equal(test, []string{"SHA-256", "SHA-512"}, got)
+}
+
+func TestHashRegistry_Restricted(test *testing.T) {
+	type args struct {
+		allowedNames []string
+	}
+
+	for _, data := range []struct {
+		name      string
+		args      args
+		wantNames []string
+		wantErr   assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/with allowed names",
+			args: args{
+				allowedNames: []string{"SHA-256", "SHA-512"},
+			},
+			wantNames: []string{"SHA-256", "SHA-512"},
+			wantErr:   assert.NoError,
+		},
+		{
+			name: "success/without allowed names",
+			args: args{
+				allowedNames: nil,
+			},
+			wantNames: []string{},
+			wantErr:   assert.NoError,
+		},
+		{
+			name: "error",
+			args: args{
+				allowedNames: []string{"SHA-256", "dummy"},
+			},
+			wantNames: nil,
+			wantErr:   assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			registry := NewHashRegistry()
+			for _, name := range []string{"SHA-224", "SHA-256", "SHA-512"} {
+				err := registry.Register(name, sha256.New)
+				require.NoError(test, err)
+			}
+
+			got, err := registry.Restricted(data.args.allowedNames...)
+
+			if err == nil {
+				assert.ElementsMatch(test, data.wantNames, got.Names())
+			}
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestDefaultHashRegistry(test *testing.T) {
+	for _, data := range []struct {
+		name         string
+		wantHashSize int
+	}{
+		{name: "MD5", wantHashSize: 16},
+		{name: "SHA-1", wantHashSize: 20},
+		{name: "SHA-224", wantHashSize: 28},
+		{name: "SHA-256", wantHashSize: 32},
+		{name: "SHA-384", wantHashSize: 48},
+		{name: "SHA-512", wantHashSize: 64},
+		{name: "SHA-512/224", wantHashSize: 28},
+		{name: "SHA-512/256", wantHashSize: 32},
+		{name: "FNV-32", wantHashSize: 4},
+		{name: "FNV-32a", wantHashSize: 4},
+		{name: "FNV-64", wantHashSize: 8},
+		{name: "FNV-64a", wantHashSize: 8},
+		{name: "FNV-128", wantHashSize: 16},
+		{name: "FNV-128a", wantHashSize: 16},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err := DefaultHashRegistry.Lookup(data.name)
+
+			assert.Equal(test, data.name, got.Name())
+			assert.Equal(test, data.wantHashSize, got.SizeInBytes())
+			assert.NoError(test, err)
+		})
+	}
+}
@@ -1,5 +1,10 @@
 package powValueTypes
 
+import (
+	"encoding/hex"
+	"fmt"
+)
+
 type HashSum struct {
 	rawValue []byte
 }
@@ -14,6 +19,15 @@ func NewHashSum(rawValue []byte) HashSum {
 	}
 }
 
+func ParseHashSum(rawValue string) (HashSum, error) {
+	parsedRawValue, err := hex.DecodeString(rawValue)
+	if err != nil {
+		return HashSum{}, fmt.Errorf("unable to decode the hex string: %w", err)
+	}
+
+	return NewHashSum(parsedRawValue), nil
+}
+
 func (value HashSum) Len() int {
 	return len(value.rawValue)
 }
@@ -21,3 +35,7 @@ func (value HashSum) Len() int {
 func (value HashSum) ToBytes() []byte {
 	return value.rawValue
 }
+
+func (value HashSum) ToString() string {
+	return hex.EncodeToString(value.rawValue)
+}
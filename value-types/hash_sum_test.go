@@ -52,6 +52,55 @@ func TestNewHashSum(test *testing.T) {
 	}
 }
 
+func TestParseHashSum(test *testing.T) {
+	type args struct {
+		rawValue string
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		want    HashSum
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/non-empty",
+			args: args{
+				rawValue: "64756d6d79",
+			},
+			want: HashSum{
+				rawValue: []byte("dummy"),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/empty",
+			args: args{
+				rawValue: "",
+			},
+			want: HashSum{
+				rawValue: []byte{},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error",
+			args: args{
+				rawValue: "dummy",
+			},
+			want:    HashSum{},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err := ParseHashSum(data.args.rawValue)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
+
 func TestHashSum_Len(test *testing.T) {
 	type fields struct {
 		rawValue []byte
@@ -109,3 +158,39 @@ func TestHashSum_ToBytes(test *testing.T) {
 		})
 	}
 }
+
+func TestHashSum_ToString(test *testing.T) {
+	type fields struct {
+		rawValue []byte
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		want   string
+	}{
+		{
+			name: "success/non-empty",
+			fields: fields{
+				rawValue: []byte("dummy"),
+			},
+			want: "64756d6d79",
+		},
+		{
+			name: "success/empty",
+			fields: fields{
+				rawValue: []byte{},
+			},
+			want: "",
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			value := HashSum{
+				rawValue: data.fields.rawValue,
+			}
+			got := value.ToString()
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
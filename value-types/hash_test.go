@@ -1,8 +1,11 @@
 package powValueTypes
 
 import (
+	"crypto/hmac"
 	"crypto/sha256"
+	"fmt"
 	"hash"
+	"sync"
 	"testing"
 
 	"github.com/samber/mo"
@@ -26,6 +29,7 @@ func TestNewHash(test *testing.T) {
 			},
 			want: Hash{
 				rawValue: sha256.New(),
+				mutex:    &sync.Mutex{},
 			},
 		},
 	} {
@@ -57,6 +61,7 @@ func TestNewHashWithName(test *testing.T) {
 			},
 			want: Hash{
 				rawValue: sha256.New(),
+				mutex:    &sync.Mutex{},
 				name:     mo.Some("SHA-256"),
 			},
 			wantErr: assert.NoError,
@@ -80,6 +85,82 @@ func TestNewHashWithName(test *testing.T) {
 	}
 }
 
+func TestNewHashFromFactory(test *testing.T) {
+	type args struct {
+		factory func() hash.Hash
+	}
+
+	for _, data := range []struct {
+		name string
+		args args
+		want hash.Hash
+	}{
+		{
+			name: "success",
+			args: args{
+				factory: sha256.New,
+			},
+			want: sha256.New(),
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got := NewHashFromFactory(data.args.factory)
+
+			assert.Equal(test, data.want, got.rawValue)
+			assert.NotNil(test, got.pool)
+			assert.Equal(test, mo.None[string](), got.name)
+		})
+	}
+}
+
+func TestNewHashFromFactoryWithName(test *testing.T) {
+	type args struct {
+		factory func() hash.Hash
+		name    string
+	}
+
+	for _, data := range []struct {
+		name     string
+		args     args
+		want     hash.Hash
+		wantName mo.Option[string]
+		wantPool assert.ValueAssertionFunc
+		wantErr  assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				factory: sha256.New,
+				name:    "SHA-256",
+			},
+			want:     sha256.New(),
+			wantName: mo.Some("SHA-256"),
+			wantPool: assert.NotNil,
+			wantErr:  assert.NoError,
+		},
+		{
+			name: "error",
+			args: args{
+				factory: sha256.New,
+				name:    "",
+			},
+			want:     nil,
+			wantName: mo.None[string](),
+			wantPool: assert.Nil,
+			wantErr:  assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err := NewHashFromFactoryWithName(data.args.factory, data.args.name)
+
+			assert.Equal(test, data.want, got.rawValue)
+			data.wantPool(test, got.pool)
+			assert.Equal(test, data.wantName, got.name)
+			data.wantErr(test, err)
+		})
+	}
+}
+
 func TestHash_Name(test *testing.T) {
 	type fields struct {
 		rawValue hash.Hash
@@ -105,7 +186,9 @@ func TestHash_Name(test *testing.T) {
 				rawValue: sha256.New(),
 				name:     mo.None[string](),
 			},
-			want: "*sha256.digest",
+			// the name of the type that implements SHA-256 depends
+			// on the Go version (for example, `*sha256.digest` or `*sha256.Digest`)
+			want: fmt.Sprintf("%T", sha256.New()),
 		},
 	} {
 		test.Run(data.name, func(test *testing.T) {
@@ -120,6 +203,115 @@ func TestHash_Name(test *testing.T) {
 	}
 }
 
+func TestHash_HasExplicitName(test *testing.T) {
+	type fields struct {
+		rawValue hash.Hash
+		name     mo.Option[string]
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		want   assert.BoolAssertionFunc
+	}{
+		{
+			name: "success/with a name",
+			fields: fields{
+				rawValue: sha256.New(),
+				name:     mo.Some("SHA-256"),
+			},
+			want: assert.True,
+		},
+		{
+			name: "success/without a name",
+			fields: fields{
+				rawValue: sha256.New(),
+				name:     mo.None[string](),
+			},
+			want: assert.False,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			value := Hash{
+				rawValue: data.fields.rawValue,
+				name:     data.fields.name,
+			}
+			got := value.HasExplicitName()
+
+			data.want(test, got)
+		})
+	}
+}
+
+func TestHash_Clone(test *testing.T) {
+	type fields struct {
+		rawValue hash.Hash
+		pool     *sync.Pool
+		mutex    *sync.Mutex
+		name     mo.Option[string]
+	}
+
+	for _, data := range []struct {
+		name            string
+		fields          fields
+		wantRawInstance assert.ComparisonAssertionFunc
+		wantErr         assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/from an instance",
+			fields: fields{
+				rawValue: sha256.New(),
+				pool:     nil,
+				mutex:    &sync.Mutex{},
+				name:     mo.Some("SHA-256"),
+			},
+			wantRawInstance: assert.NotSame,
+			wantErr:         assert.NoError,
+		},
+		{
+			name: "success/from a factory",
+			fields: fields{
+				rawValue: sha256.New(),
+				pool:     makeHashPool(sha256.New),
+				mutex:    nil,
+				name:     mo.Some("SHA-256"),
+			},
+			wantRawInstance: assert.Same,
+			wantErr:         assert.NoError,
+		},
+		{
+			name: "error/state marshaling isn't supported",
+			fields: fields{
+				rawValue: hmac.New(sha256.New, []byte("dummy")),
+				pool:     nil,
+				mutex:    &sync.Mutex{},
+				name:     mo.Some("HMAC-SHA-256"),
+			},
+			wantRawInstance: nil,
+			wantErr:         assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			value := Hash{
+				rawValue: data.fields.rawValue,
+				pool:     data.fields.pool,
+				mutex:    data.fields.mutex,
+				name:     data.fields.name,
+			}
+			got, err := value.Clone()
+
+			data.wantErr(test, err)
+			if err == nil {
+				data.wantRawInstance(test, value.rawValue, got.rawValue)
+				assert.Equal(test, value.Name(), got.Name())
+				assert.Equal(test, value.ApplyTo("dummy"), got.ApplyTo("dummy"))
+			} else {
+				assert.Equal(test, Hash{}, got)
+			}
+		})
+	}
+}
+
 func TestHash_SizeInBytes(test *testing.T) {
 	type fields struct {
 		rawValue hash.Hash
@@ -181,6 +373,7 @@ func TestHash_SizeInBits(test *testing.T) {
 func TestHash_ApplyTo(test *testing.T) {
 	type fields struct {
 		rawValue hash.Hash
+		pool     *sync.Pool
 	}
 	type args struct {
 		data string
@@ -227,10 +420,33 @@ func TestHash_ApplyTo(test *testing.T) {
 				0xfc, 0xb9, 0x31, 0xee, 0x3b, 0x55, 0x82, 0x59,
 			}),
 		},
+		{
+			name: "success/from a factory",
+			fields: fields{
+				rawValue: func() hash.Hash {
+					hash := sha256.New()
+					hash.Write([]byte("prefix"))
+
+					return hash
+				}(),
+				pool: makeHashPool(sha256.New),
+			},
+			args: args{
+				data: "dummy",
+			},
+			want: NewHashSum([]byte{
+				0xb5, 0xa2, 0xc9, 0x62, 0x50, 0x61, 0x23, 0x66,
+				0xea, 0x27, 0x2f, 0xfa, 0xc6, 0xd9, 0x74, 0x4a,
+				0xaf, 0x4b, 0x45, 0xaa, 0xcd, 0x96, 0xaa, 0x7c,
+				0xfc, 0xb9, 0x31, 0xee, 0x3b, 0x55, 0x82, 0x59,
+			}),
+		},
 	} {
 		test.Run(data.name, func(test *testing.T) {
 			value := Hash{
 				rawValue: data.fields.rawValue,
+				pool:     data.fields.pool,
+				mutex:    &sync.Mutex{},
 			}
 			got := value.ApplyTo(data.args.data)
 
@@ -239,6 +455,45 @@ func TestHash_ApplyTo(test *testing.T) {
 	}
 }
 
+func TestHash_ApplyTo_concurrently(test *testing.T) {
+	for _, data := range []struct {
+		name  string
+		value Hash
+	}{
+		{
+			name:  "success/from an instance",
+			value: NewHash(sha256.New()),
+		},
+		{
+			name:  "success/from a factory",
+			value: NewHashFromFactory(sha256.New),
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			want := NewHashSum([]byte{
+				0xb5, 0xa2, 0xc9, 0x62, 0x50, 0x61, 0x23, 0x66,
+				0xea, 0x27, 0x2f, 0xfa, 0xc6, 0xd9, 0x74, 0x4a,
+				0xaf, 0x4b, 0x45, 0xaa, 0xcd, 0x96, 0xaa, 0x7c,
+				0xfc, 0xb9, 0x31, 0xee, 0x3b, 0x55, 0x82, 0x59,
+			})
+
+			var waitGroup sync.WaitGroup
+			for range 10 {
+				waitGroup.Add(1)
+				go func() {
+					defer waitGroup.Done()
+
+					for range 100 {
+						got := data.value.ApplyTo("dummy")
+						assert.Equal(test, want, got)
+					}
+				}()
+			}
+			waitGroup.Wait()
+		})
+	}
+}
+
 func TestHash_ToHash(test *testing.T) {
 	type fields struct {
 		rawValue hash.Hash
@@ -7,6 +7,7 @@ import (
 	"io"
 	"math/big"
 
+	"github.com/samber/mo"
 	powErrors "github.com/thewizardplusplus/go-pow/errors"
 )
 
@@ -16,6 +17,7 @@ const (
 
 type Nonce struct {
 	rawValue *big.Int
+	encoding mo.Option[NonceEncoding]
 }
 
 func NewNonce(rawValue *big.Int) (Nonce, error) {
@@ -100,10 +102,28 @@ func (value Nonce) Incremented() (Nonce, error) {
 	return result, nil
 }
 
+func (value Nonce) IncrementedBy(delta *big.Int) (Nonce, error) {
+	rawResult := big.NewInt(0)
+	rawResult.Add(value.rawValue, delta)
+
+	result, err := NewNonce(rawResult)
+	if err != nil {
+		return Nonce{}, fmt.Errorf("unable to construct the nonce: %w", err)
+	}
+
+	return result, nil
+}
+
+// the encoding affects only the string representation of the nonce
+func (value Nonce) WithEncoding(encoding NonceEncoding) Nonce {
+	value.encoding = mo.Some(encoding)
+	return value
+}
+
 func (value Nonce) ToBigInt() *big.Int {
 	return value.rawValue
 }
 
 func (value Nonce) ToString() string {
-	return value.rawValue.Text(NonceRepresentationBase)
+	return value.encoding.OrElse(DefaultNonceEncoding).Encode(value)
 }
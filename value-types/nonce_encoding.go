@@ -0,0 +1,183 @@
+package powValueTypes
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+type NonceEncodingKind string
+
+const (
+	NonceEncodingKindDecimal   NonceEncodingKind = "decimal"
+	NonceEncodingKindHex       NonceEncodingKind = "hex"
+	NonceEncodingKindBase36    NonceEncodingKind = "base36"
+	NonceEncodingKindBase64URL NonceEncodingKind = "base64url"
+	NonceEncodingKindBytes     NonceEncodingKind = "bytes"
+)
+
+const (
+	MaxNonceEncodingWidthInBytes = 64
+)
+
+var (
+	DefaultNonceEncoding = NonceEncoding{
+		kind: NonceEncodingKindDecimal,
+	}
+)
+
+// the encodings are canonical, i.e. each nonce has exactly one representation:
+//   - `decimal`, `hex` and `base36` are numbers in lowercase
+//     without leading zeros;
+//   - `base64url` is the big-endian bytes of the nonce without leading zeros
+//     (and without padding);
+//   - `bytes:<width>` is the big-endian bytes of the nonce padded with zeros
+//     to the fixed width (a nonce that exceeds the width is written in full,
+//     so the encoding remains unambiguous)
+type NonceEncoding struct {
+	kind         NonceEncodingKind
+	widthInBytes int
+}
+
+func NewNonceEncoding(kind NonceEncodingKind) (NonceEncoding, error) {
+	switch kind {
+	case NonceEncodingKindDecimal,
+		NonceEncodingKindHex,
+		NonceEncodingKindBase36,
+		NonceEncodingKindBase64URL:
+		value := NonceEncoding{
+			kind: kind,
+		}
+		return value, nil
+
+	case NonceEncodingKindBytes:
+		return NonceEncoding{}, errors.New("nonce encoding requires a width")
+	}
+
+	return NonceEncoding{}, fmt.Errorf("unknown nonce encoding kind %q", kind)
+}
+
+func NewFixedWidthNonceEncoding(widthInBytes int) (NonceEncoding, error) {
+	if widthInBytes <= 0 || widthInBytes > MaxNonceEncodingWidthInBytes {
+		return NonceEncoding{}, fmt.Errorf(
+			"nonce width must be in the range [1, %d]",
+			MaxNonceEncodingWidthInBytes,
+		)
+	}
+
+	value := NonceEncoding{
+		kind:         NonceEncodingKindBytes,
+		widthInBytes: widthInBytes,
+	}
+	return value, nil
+}
+
+func ParseNonceEncoding(rawValue string) (NonceEncoding, error) {
+	rawKind, rawWidth, isWidthPresent := strings.Cut(rawValue, ":")
+	if NonceEncodingKind(rawKind) != NonceEncodingKindBytes {
+		if isWidthPresent {
+			return NonceEncoding{}, fmt.Errorf(
+				"nonce encoding %q doesn't accept a width",
+				rawKind,
+			)
+		}
+
+		return NewNonceEncoding(NonceEncodingKind(rawKind))
+	}
+	if !isWidthPresent {
+		return NonceEncoding{}, errors.New("nonce encoding requires a width")
+	}
+
+	widthInBytes, err := strconv.Atoi(rawWidth)
+	if err != nil {
+		return NonceEncoding{}, fmt.Errorf(
+			"unable to parse the nonce width: %w",
+			err,
+		)
+	}
+
+	return NewFixedWidthNonceEncoding(widthInBytes)
+}
+
+func (value NonceEncoding) Kind() NonceEncodingKind {
+	return value.kind
+}
+
+func (value NonceEncoding) Encode(nonce Nonce) string {
+	switch value.kind {
+	case NonceEncodingKindBase64URL:
+		return base64.RawURLEncoding.EncodeToString(nonce.rawValue.Bytes())
+
+	case NonceEncodingKindBytes:
+		if (nonce.rawValue.BitLen()+7)/8 > value.widthInBytes {
+			return string(nonce.rawValue.Bytes())
+		}
+
+		return string(nonce.rawValue.FillBytes(make([]byte, value.widthInBytes)))
+	}
+
+	return nonce.rawValue.Text(value.numberBase())
+}
+
+func (value NonceEncoding) Decode(rawValue string) (Nonce, error) {
+	parsedRawValue := big.NewInt(0)
+	switch value.kind {
+	case NonceEncodingKindBase64URL:
+		bytes, err := base64.RawURLEncoding.DecodeString(rawValue)
+		if err != nil {
+			return Nonce{}, fmt.Errorf("unable to decode the nonce: %w", err)
+		}
+
+		parsedRawValue.SetBytes(bytes)
+
+	case NonceEncodingKindBytes:
+		if len(rawValue) < value.widthInBytes {
+			return Nonce{}, errors.New("nonce is shorter than the width")
+		}
+
+		parsedRawValue.SetBytes([]byte(rawValue))
+
+	default:
+		if _, isParsed := parsedRawValue.SetString(
+			rawValue,
+			value.numberBase(),
+		); !isParsed {
+			return Nonce{}, errors.New("unable to parse the big integer")
+		}
+	}
+
+	nonce, err := NewNonce(parsedRawValue)
+	if err != nil {
+		return Nonce{}, fmt.Errorf("unable to construct the nonce: %w", err)
+	}
+
+	// signs, leading zeros and uppercase letters are rejected,
+	// so the same nonce cannot be submitted in several forms
+	if value.Encode(nonce) != rawValue {
+		return Nonce{}, errors.New("nonce representation isn't canonical")
+	}
+
+	return nonce, nil
+}
+
+func (value NonceEncoding) ToString() string {
+	if value.kind == NonceEncodingKindBytes {
+		return string(value.kind) + ":" + strconv.Itoa(value.widthInBytes)
+	}
+
+	return string(value.kind)
+}
+
+func (value NonceEncoding) numberBase() int {
+	switch value.kind {
+	case NonceEncodingKindHex:
+		return 16
+	case NonceEncodingKindBase36:
+		return 36
+	}
+
+	return NonceRepresentationBase
+}
@@ -0,0 +1,492 @@
+package powValueTypes
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewNonceEncoding(test *testing.T) {
+	type args struct {
+		kind NonceEncodingKind
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		want    NonceEncoding
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				kind: NonceEncodingKindHex,
+			},
+			want: NonceEncoding{
+				kind: NonceEncodingKindHex,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/width is required",
+			args: args{
+				kind: NonceEncodingKindBytes,
+			},
+			want:    NonceEncoding{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/unknown kind",
+			args: args{
+				kind: "dummy",
+			},
+			want:    NonceEncoding{},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err := NewNonceEncoding(data.args.kind)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestNewFixedWidthNonceEncoding(test *testing.T) {
+	type args struct {
+		widthInBytes int
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		want    NonceEncoding
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				widthInBytes: 8,
+			},
+			want: NonceEncoding{
+				kind:         NonceEncodingKindBytes,
+				widthInBytes: 8,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/zero width",
+			args: args{
+				widthInBytes: 0,
+			},
+			want:    NonceEncoding{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/too large width",
+			args: args{
+				widthInBytes: MaxNonceEncodingWidthInBytes + 1,
+			},
+			want:    NonceEncoding{},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err := NewFixedWidthNonceEncoding(data.args.widthInBytes)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestParseNonceEncoding(test *testing.T) {
+	type args struct {
+		rawValue string
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		want    NonceEncoding
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/without a width",
+			args: args{
+				rawValue: "base36",
+			},
+			want: NonceEncoding{
+				kind: NonceEncodingKindBase36,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/with a width",
+			args: args{
+				rawValue: "bytes:8",
+			},
+			want: NonceEncoding{
+				kind:         NonceEncodingKindBytes,
+				widthInBytes: 8,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/unexpected width",
+			args: args{
+				rawValue: "hex:8",
+			},
+			want:    NonceEncoding{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/missed width",
+			args: args{
+				rawValue: "bytes",
+			},
+			want:    NonceEncoding{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/invalid width",
+			args: args{
+				rawValue: "bytes:dummy",
+			},
+			want:    NonceEncoding{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/unknown kind",
+			args: args{
+				rawValue: "dummy",
+			},
+			want:    NonceEncoding{},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err := ParseNonceEncoding(data.args.rawValue)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestNonceEncoding_Encode(test *testing.T) {
+	type fields struct {
+		kind         NonceEncodingKind
+		widthInBytes int
+	}
+	type args struct {
+		nonce Nonce
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		args   args
+		want   string
+	}{
+		{
+			name: "success/decimal",
+			fields: fields{
+				kind: NonceEncodingKindDecimal,
+			},
+			args: args{
+				nonce: Nonce{rawValue: big.NewInt(12345)},
+			},
+			want: "12345",
+		},
+		{
+			name: "success/hex",
+			fields: fields{
+				kind: NonceEncodingKindHex,
+			},
+			args: args{
+				nonce: Nonce{rawValue: big.NewInt(12345)},
+			},
+			want: "3039",
+		},
+		{
+			name: "success/base36",
+			fields: fields{
+				kind: NonceEncodingKindBase36,
+			},
+			args: args{
+				nonce: Nonce{rawValue: big.NewInt(12345)},
+			},
+			want: "9ix",
+		},
+		{
+			name: "success/base64url",
+			fields: fields{
+				kind: NonceEncodingKindBase64URL,
+			},
+			args: args{
+				nonce: Nonce{rawValue: big.NewInt(0xfbff)},
+			},
+			want: "-_8",
+		},
+		{
+			name: "success/base64url/zero",
+			fields: fields{
+				kind: NonceEncodingKindBase64URL,
+			},
+			args: args{
+				nonce: Nonce{rawValue: big.NewInt(0)},
+			},
+			want: "",
+		},
+		{
+			name: "success/bytes",
+			fields: fields{
+				kind:         NonceEncodingKindBytes,
+				widthInBytes: 4,
+			},
+			args: args{
+				nonce: Nonce{rawValue: big.NewInt(0x3039)},
+			},
+			want: "\x00\x00\x30\x39",
+		},
+		{
+			name: "success/bytes/exceeding the width",
+			fields: fields{
+				kind:         NonceEncodingKindBytes,
+				widthInBytes: 1,
+			},
+			args: args{
+				nonce: Nonce{rawValue: big.NewInt(0x3039)},
+			},
+			want: "\x30\x39",
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			value := NonceEncoding{
+				kind:         data.fields.kind,
+				widthInBytes: data.fields.widthInBytes,
+			}
+			got := value.Encode(data.args.nonce)
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
+func TestNonceEncoding_Decode(test *testing.T) {
+	type fields struct {
+		kind         NonceEncodingKind
+		widthInBytes int
+	}
+	type args struct {
+		rawValue string
+	}
+
+	for _, data := range []struct {
+		name    string
+		fields  fields
+		args    args
+		want    Nonce
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/decimal",
+			fields: fields{
+				kind: NonceEncodingKindDecimal,
+			},
+			args: args{
+				rawValue: "12345",
+			},
+			want:    Nonce{rawValue: big.NewInt(12345)},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/hex",
+			fields: fields{
+				kind: NonceEncodingKindHex,
+			},
+			args: args{
+				rawValue: "3039",
+			},
+			want:    Nonce{rawValue: big.NewInt(12345)},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/base36",
+			fields: fields{
+				kind: NonceEncodingKindBase36,
+			},
+			args: args{
+				rawValue: "9ix",
+			},
+			want:    Nonce{rawValue: big.NewInt(12345)},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/base64url",
+			fields: fields{
+				kind: NonceEncodingKindBase64URL,
+			},
+			args: args{
+				rawValue: "-_8",
+			},
+			want:    Nonce{rawValue: big.NewInt(0xfbff)},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/bytes",
+			fields: fields{
+				kind:         NonceEncodingKindBytes,
+				widthInBytes: 4,
+			},
+			args: args{
+				rawValue: "\x00\x00\x30\x39",
+			},
+			want:    Nonce{rawValue: big.NewInt(0x3039)},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/invalid number",
+			fields: fields{
+				kind: NonceEncodingKindHex,
+			},
+			args: args{
+				rawValue: "dummy",
+			},
+			want:    Nonce{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/negative number",
+			fields: fields{
+				kind: NonceEncodingKindDecimal,
+			},
+			args: args{
+				rawValue: "-23",
+			},
+			want:    Nonce{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/non-canonical number/leading zeros",
+			fields: fields{
+				kind: NonceEncodingKindDecimal,
+			},
+			args: args{
+				rawValue: "0023",
+			},
+			want:    Nonce{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/non-canonical number/uppercase letters",
+			fields: fields{
+				kind: NonceEncodingKindHex,
+			},
+			args: args{
+				rawValue: "3A",
+			},
+			want:    Nonce{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/invalid base64url",
+			fields: fields{
+				kind: NonceEncodingKindBase64URL,
+			},
+			args: args{
+				rawValue: "+/8=",
+			},
+			want:    Nonce{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/non-canonical base64url",
+			fields: fields{
+				kind: NonceEncodingKindBase64URL,
+			},
+			args: args{
+				rawValue: "AAE",
+			},
+			want:    Nonce{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/bytes/shorter than the width",
+			fields: fields{
+				kind:         NonceEncodingKindBytes,
+				widthInBytes: 4,
+			},
+			args: args{
+				rawValue: "\x30\x39",
+			},
+			want:    Nonce{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/bytes/non-canonical",
+			fields: fields{
+				kind:         NonceEncodingKindBytes,
+				widthInBytes: 1,
+			},
+			args: args{
+				rawValue: "\x00\x30\x39",
+			},
+			want:    Nonce{},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			value := NonceEncoding{
+				kind:         data.fields.kind,
+				widthInBytes: data.fields.widthInBytes,
+			}
+			got, err := value.Decode(data.args.rawValue)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestNonceEncoding_ToString(test *testing.T) {
+	type fields struct {
+		kind         NonceEncodingKind
+		widthInBytes int
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		want   string
+	}{
+		{
+			name: "success/without a width",
+			fields: fields{
+				kind: NonceEncodingKindBase64URL,
+			},
+			want: "base64url",
+		},
+		{
+			name: "success/with a width",
+			fields: fields{
+				kind:         NonceEncodingKindBytes,
+				widthInBytes: 8,
+			},
+			want: "bytes:8",
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			value := NonceEncoding{
+				kind:         data.fields.kind,
+				widthInBytes: data.fields.widthInBytes,
+			}
+			got := value.ToString()
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
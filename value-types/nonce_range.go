@@ -0,0 +1,81 @@
+package powValueTypes
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+)
+
+type NonceRange struct {
+	start Nonce
+	end   Nonce
+}
+
+func NewNonceRange(start Nonce, end Nonce) (NonceRange, error) {
+	if start.rawValue.Cmp(end.rawValue) >= 0 {
+		return NonceRange{}, errors.New("nonce range cannot be empty")
+	}
+
+	value := NonceRange{
+		start: start,
+		end:   end,
+	}
+	return value, nil
+}
+
+func (value NonceRange) Start() Nonce {
+	return value.start
+}
+
+// the end of the range is exclusive
+func (value NonceRange) End() Nonce {
+	return value.end
+}
+
+func (value NonceRange) Size() *big.Int {
+	return big.NewInt(0).Sub(value.end.rawValue, value.start.rawValue)
+}
+
+func (value NonceRange) Contains(nonce Nonce) bool {
+	return nonce.rawValue.Cmp(value.start.rawValue) >= 0 &&
+		nonce.rawValue.Cmp(value.end.rawValue) < 0
+}
+
+func (value NonceRange) Split(shardCount int) ([]NonceRange, error) {
+	if shardCount < 1 {
+		return nil, errors.New("shard count must be positive")
+	}
+
+	rawShardCount := big.NewInt(int64(shardCount))
+	baseShardSize, remainder :=
+		big.NewInt(0).QuoRem(value.Size(), rawShardCount, big.NewInt(0))
+	if baseShardSize.Sign() == 0 {
+		return nil, errors.New("shard count exceeds the nonce range size")
+	}
+
+	// the remainder is distributed among the first shards,
+	// so the shard sizes differ by one at most
+	shards := make([]NonceRange, 0, shardCount)
+	shardStart := value.start
+	for shardIndex := range shardCount {
+		shardSize := big.NewInt(0).Set(baseShardSize)
+		if big.NewInt(int64(shardIndex)).Cmp(remainder) < 0 {
+			shardSize.Add(shardSize, big.NewInt(1))
+		}
+
+		shardEnd, err := shardStart.IncrementedBy(shardSize)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get the shard end: %w", err)
+		}
+
+		shard, err := NewNonceRange(shardStart, shardEnd)
+		if err != nil {
+			return nil, fmt.Errorf("unable to construct the shard: %w", err)
+		}
+
+		shards = append(shards, shard)
+		shardStart = shardEnd
+	}
+
+	return shards, nil
+}
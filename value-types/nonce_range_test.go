@@ -0,0 +1,237 @@
+package powValueTypes
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewNonceRange(test *testing.T) {
+	type args struct {
+		start Nonce
+		end   Nonce
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		want    NonceRange
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				start: Nonce{rawValue: big.NewInt(23)},
+				end:   Nonce{rawValue: big.NewInt(42)},
+			},
+			want: NonceRange{
+				start: Nonce{rawValue: big.NewInt(23)},
+				end:   Nonce{rawValue: big.NewInt(42)},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/empty range",
+			args: args{
+				start: Nonce{rawValue: big.NewInt(23)},
+				end:   Nonce{rawValue: big.NewInt(23)},
+			},
+			want:    NonceRange{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/reversed range",
+			args: args{
+				start: Nonce{rawValue: big.NewInt(42)},
+				end:   Nonce{rawValue: big.NewInt(23)},
+			},
+			want:    NonceRange{},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err := NewNonceRange(data.args.start, data.args.end)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestNonceRange_Start(test *testing.T) {
+	value := NonceRange{
+		start: Nonce{rawValue: big.NewInt(23)},
+		end:   Nonce{rawValue: big.NewInt(42)},
+	}
+	got := value.Start()
+
+	assert.Equal(test, Nonce{rawValue: big.NewInt(23)}, got)
+}
+
+func TestNonceRange_End(test *testing.T) {
+	value := NonceRange{
+		start: Nonce{rawValue: big.NewInt(23)},
+		end:   Nonce{rawValue: big.NewInt(42)},
+	}
+	got := value.End()
+
+	assert.Equal(test, Nonce{rawValue: big.NewInt(42)}, got)
+}
+
+func TestNonceRange_Size(test *testing.T) {
+	value := NonceRange{
+		start: Nonce{rawValue: big.NewInt(23)},
+		end:   Nonce{rawValue: big.NewInt(42)},
+	}
+	got := value.Size()
+
+	assert.Equal(test, big.NewInt(19), got)
+}
+
+func TestNonceRange_Contains(test *testing.T) {
+	type args struct {
+		nonce Nonce
+	}
+
+	for _, data := range []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "below the start",
+			args: args{
+				nonce: Nonce{rawValue: big.NewInt(22)},
+			},
+			want: false,
+		},
+		{
+			name: "start",
+			args: args{
+				nonce: Nonce{rawValue: big.NewInt(23)},
+			},
+			want: true,
+		},
+		{
+			name: "inside",
+			args: args{
+				nonce: Nonce{rawValue: big.NewInt(30)},
+			},
+			want: true,
+		},
+		{
+			name: "end",
+			args: args{
+				nonce: Nonce{rawValue: big.NewInt(42)},
+			},
+			want: false,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			value := NonceRange{
+				start: Nonce{rawValue: big.NewInt(23)},
+				end:   Nonce{rawValue: big.NewInt(42)},
+			}
+			got := value.Contains(data.args.nonce)
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
+func TestNonceRange_Split(test *testing.T) {
+	type args struct {
+		shardCount int
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		want    []NonceRange
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/single shard",
+			args: args{
+				shardCount: 1,
+			},
+			want: []NonceRange{
+				{
+					start: Nonce{rawValue: big.NewInt(20)},
+					end:   Nonce{rawValue: big.NewInt(30)},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/even shards",
+			args: args{
+				shardCount: 2,
+			},
+			want: []NonceRange{
+				{
+					start: Nonce{rawValue: big.NewInt(20)},
+					end:   Nonce{rawValue: big.NewInt(25)},
+				},
+				{
+					start: Nonce{rawValue: big.NewInt(25)},
+					end:   Nonce{rawValue: big.NewInt(30)},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/uneven shards",
+			args: args{
+				shardCount: 4,
+			},
+			want: []NonceRange{
+				{
+					start: Nonce{rawValue: big.NewInt(20)},
+					end:   Nonce{rawValue: big.NewInt(23)},
+				},
+				{
+					start: Nonce{rawValue: big.NewInt(23)},
+					end:   Nonce{rawValue: big.NewInt(26)},
+				},
+				{
+					start: Nonce{rawValue: big.NewInt(26)},
+					end:   Nonce{rawValue: big.NewInt(28)},
+				},
+				{
+					start: Nonce{rawValue: big.NewInt(28)},
+					end:   Nonce{rawValue: big.NewInt(30)},
+				},
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/shard count isn't positive",
+			args: args{
+				shardCount: 0,
+			},
+			want:    nil,
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/shard count exceeds the range size",
+			args: args{
+				shardCount: 11,
+			},
+			want:    nil,
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			value := NonceRange{
+				start: Nonce{rawValue: big.NewInt(20)},
+				end:   Nonce{rawValue: big.NewInt(30)},
+			}
+			got, err := value.Split(data.args.shardCount)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
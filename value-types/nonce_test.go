@@ -6,6 +6,7 @@ import (
 	"testing"
 	"testing/iotest"
 
+	"github.com/samber/mo"
 	"github.com/stretchr/testify/assert"
 	powErrors "github.com/thewizardplusplus/go-pow/errors"
 )
@@ -256,6 +257,71 @@ func TestNonce_Incremented(test *testing.T) {
 	}
 }
 
+func TestNonce_IncrementedBy(test *testing.T) {
+	type fields struct {
+		rawValue *big.Int
+	}
+	type args struct {
+		delta *big.Int
+	}
+
+	for _, data := range []struct {
+		name    string
+		fields  fields
+		args    args
+		want    Nonce
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/positive delta",
+			fields: fields{
+				rawValue: big.NewInt(23),
+			},
+			args: args{
+				delta: big.NewInt(42),
+			},
+			want: Nonce{
+				rawValue: big.NewInt(65),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/zero delta",
+			fields: fields{
+				rawValue: big.NewInt(23),
+			},
+			args: args{
+				delta: big.NewInt(0),
+			},
+			want: Nonce{
+				rawValue: big.NewInt(23),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error",
+			fields: fields{
+				rawValue: big.NewInt(23),
+			},
+			args: args{
+				delta: big.NewInt(-42),
+			},
+			want:    Nonce{},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			value := Nonce{
+				rawValue: data.fields.rawValue,
+			}
+			got, err := value.IncrementedBy(data.args.delta)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
+
 func TestNonce_ToBigInt(test *testing.T) {
 	type fields struct {
 		rawValue *big.Int
@@ -285,9 +351,26 @@ func TestNonce_ToBigInt(test *testing.T) {
 	}
 }
 
+func TestNonce_WithEncoding(test *testing.T) {
+	value := Nonce{
+		rawValue: big.NewInt(23),
+	}
+	got := value.WithEncoding(NonceEncoding{kind: NonceEncodingKindHex})
+
+	assert.Equal(
+		test,
+		Nonce{
+			rawValue: big.NewInt(23),
+			encoding: mo.Some(NonceEncoding{kind: NonceEncodingKindHex}),
+		},
+		got,
+	)
+}
+
 func TestNonce_ToString(test *testing.T) {
 	type fields struct {
 		rawValue *big.Int
+		encoding mo.Option[NonceEncoding]
 	}
 
 	for _, data := range []struct {
@@ -296,16 +379,26 @@ func TestNonce_ToString(test *testing.T) {
 		want   string
 	}{
 		{
-			name: "success",
+			name: "success/without an encoding",
 			fields: fields{
 				rawValue: big.NewInt(23),
+				encoding: mo.None[NonceEncoding](),
 			},
 			want: "23",
 		},
+		{
+			name: "success/with an encoding",
+			fields: fields{
+				rawValue: big.NewInt(23),
+				encoding: mo.Some(NonceEncoding{kind: NonceEncodingKindHex}),
+			},
+			want: "17",
+		},
 	} {
 		test.Run(data.name, func(test *testing.T) {
 			value := Nonce{
 				rawValue: data.fields.rawValue,
+				encoding: data.fields.encoding,
 			}
 			got := value.ToString()
 
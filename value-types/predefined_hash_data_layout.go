@@ -0,0 +1,73 @@
+package powValueTypes
+
+import (
+	"fmt"
+	"maps"
+	"slices"
+)
+
+const (
+	HashDataLayoutNameV1Colon          = "v1-colon"
+	HashDataLayoutNameV1LengthPrefixed = "v1-length-prefixed"
+)
+
+// the predefined layouts cover all the fields of a challenge and start
+// with their names, so the hash inputs of different layouts never coincide:
+//   - `v1-colon` separates the fields with colons; the strings are escaped
+//     with `queryEscape`, so they never contain a colon;
+//   - `v1-length-prefixed` prefixes each field with its length
+//     using `lengthPrefix`;
+//
+// an optional field is preceded by a presence flag (0 or 1) in `v1-colon`
+// and is replaced with a dash in `v1-length-prefixed`, if it's absent;
+// the nonce is the last field in both layouts, so they can be precompiled
+var predefinedHashDataLayouts = map[string]HashDataLayout{
+	HashDataLayoutNameV1Colon: MustParseHashDataLayout(
+		HashDataLayoutNameV1Colon +
+			":{{ .Challenge.LeadingZeroBitCount.ToInt }}" +
+			":{{ .Challenge.Target.ToString }}" +
+			":{{ if .Challenge.CreatedAt.IsPresent }}" +
+			"1{{ queryEscape .Challenge.CreatedAt.MustGet.ToString }}" +
+			"{{ else }}0{{ end }}" +
+			":{{ if .Challenge.TTL.IsPresent }}" +
+			"1{{ queryEscape .Challenge.TTL.MustGet.ToString }}" +
+			"{{ else }}0{{ end }}" +
+			":{{ if .Challenge.Resource.IsPresent }}" +
+			"1{{ queryEscape .Challenge.Resource.MustGet.ToString }}" +
+			"{{ else }}0{{ end }}" +
+			":{{ queryEscape .Challenge.SerializedPayload.ToString }}" +
+			":{{ .Nonce.ToString }}",
+	),
+	HashDataLayoutNameV1LengthPrefixed: MustParseHashDataLayout(
+		HashDataLayoutNameV1LengthPrefixed +
+			"{{ print .Challenge.LeadingZeroBitCount.ToInt | lengthPrefix }}" +
+			"{{ lengthPrefix .Challenge.Target.ToString }}" +
+			"{{ if .Challenge.CreatedAt.IsPresent }}" +
+			"{{ lengthPrefix .Challenge.CreatedAt.MustGet.ToString }}" +
+			"{{ else }}-{{ end }}" +
+			"{{ if .Challenge.TTL.IsPresent }}" +
+			"{{ lengthPrefix .Challenge.TTL.MustGet.ToString }}" +
+			"{{ else }}-{{ end }}" +
+			"{{ if .Challenge.Resource.IsPresent }}" +
+			"{{ lengthPrefix .Challenge.Resource.MustGet.ToString }}" +
+			"{{ else }}-{{ end }}" +
+			"{{ lengthPrefix .Challenge.SerializedPayload.ToString }}" +
+			"{{ .Nonce.ToString }}",
+	),
+}
+
+func LookupPredefinedHashDataLayout(name string) (HashDataLayout, error) {
+	value, isPredefined := predefinedHashDataLayouts[name]
+	if !isPredefined {
+		return HashDataLayout{}, fmt.Errorf(
+			"hash data layout %q isn't predefined",
+			name,
+		)
+	}
+
+	return value, nil
+}
+
+func PredefinedHashDataLayoutNames() []string {
+	return slices.Sorted(maps.Keys(predefinedHashDataLayouts))
+}
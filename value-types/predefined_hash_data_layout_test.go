@@ -0,0 +1,72 @@
+package powValueTypes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPredefinedHashDataLayouts(test *testing.T) {
+	for _, name := range PredefinedHashDataLayoutNames() {
+		test.Run(name, func(test *testing.T) {
+			value, err := LookupPredefinedHashDataLayout(name)
+			require.NoError(test, err)
+
+			_, isSplit := value.SplitByNonce()
+
+			assert.Equal(test, allHashDataFields, value.ReferencedFields())
+			assert.True(test, isSplit)
+		})
+	}
+}
+
+func TestLookupPredefinedHashDataLayout(test *testing.T) {
+	type args struct {
+		name string
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		want    HashDataLayout
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				name: HashDataLayoutNameV1Colon,
+			},
+			want:    predefinedHashDataLayouts[HashDataLayoutNameV1Colon],
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error",
+			args: args{
+				name: "dummy",
+			},
+			want:    HashDataLayout{},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err := LookupPredefinedHashDataLayout(data.args.name)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestPredefinedHashDataLayoutNames(test *testing.T) {
+	got := PredefinedHashDataLayoutNames()
+
+	assert.Equal(
+		test,
+		[]string{
+			HashDataLayoutNameV1Colon,
+			HashDataLayoutNameV1LengthPrefixed,
+		},
+		got,
+	)
+}
@@ -0,0 +1,74 @@
+package powValueTypes
+
+import (
+	"encoding"
+	"hash"
+
+	"github.com/samber/mo"
+)
+
+type PrefixedHash struct {
+	hash   Hash
+	prefix string
+	state  mo.Option[[]byte]
+}
+
+func (value Hash) WithPrefix(prefix string) PrefixedHash {
+	var state mo.Option[[]byte]
+	value.withRawHash(func(rawValue hash.Hash) {
+		// if the hash supports it, the prefix is hashed only once,
+		// and then the resulting state is restored before each application
+		marshaler, isMarshaler := rawValue.(encoding.BinaryMarshaler)
+		_, isUnmarshaler := rawValue.(encoding.BinaryUnmarshaler)
+		if !isMarshaler || !isUnmarshaler {
+			return
+		}
+
+		rawValue.Reset()
+		rawValue.Write([]byte(prefix))
+
+		rawState, err := marshaler.MarshalBinary()
+		if err != nil {
+			return
+		}
+
+		state = mo.Some(rawState)
+	})
+
+	return PrefixedHash{
+		hash:   value,
+		prefix: prefix,
+		state:  state,
+	}
+}
+
+func (value PrefixedHash) Hash() Hash {
+	return value.hash
+}
+
+func (value PrefixedHash) Prefix() string {
+	return value.prefix
+}
+
+func (value PrefixedHash) IsPrefixStateCached() bool {
+	return value.state.IsPresent()
+}
+
+func (value PrefixedHash) ApplyTo(data string) HashSum {
+	var hashSum HashSum
+	value.hash.withRawHash(func(rawValue hash.Hash) {
+		if state, isPresent := value.state.Get(); isPresent {
+			unmarshaler := rawValue.(encoding.BinaryUnmarshaler)
+			if err := unmarshaler.UnmarshalBinary(state); err == nil {
+				rawValue.Write([]byte(data))
+				hashSum = NewHashSum(rawValue.Sum(nil))
+
+				return
+			}
+		}
+
+		hashSum = applyRawHashTo(rawValue, value.prefix+data)
+	})
+
+	return hashSum
+}
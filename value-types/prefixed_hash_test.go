@@ -0,0 +1,80 @@
+package powValueTypes
+
+import (
+	"crypto/sha256"
+	"hash"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type hashWithoutMarshaling struct {
+	hash.Hash
+}
+
+func TestHash_WithPrefix(test *testing.T) {
+	for _, data := range []struct {
+		name                    string
+		value                   Hash
+		wantIsPrefixStateCached bool
+	}{
+		{
+			name:                    "success/from an instance",
+			value:                   NewHash(sha256.New()),
+			wantIsPrefixStateCached: true,
+		},
+		{
+			name:                    "success/from a factory",
+			value:                   NewHashFromFactory(sha256.New),
+			wantIsPrefixStateCached: true,
+		},
+		{
+			name:                    "success/without marshaling",
+			value:                   NewHash(hashWithoutMarshaling{sha256.New()}),
+			wantIsPrefixStateCached: false,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got := data.value.WithPrefix("prefix")
+
+			assert.Equal(test, data.value, got.Hash())
+			assert.Equal(test, "prefix", got.Prefix())
+			assert.Equal(
+				test,
+				data.wantIsPrefixStateCached,
+				got.IsPrefixStateCached(),
+			)
+		})
+	}
+}
+
+func TestPrefixedHash_ApplyTo(test *testing.T) {
+	for _, data := range []struct {
+		name  string
+		value Hash
+	}{
+		{
+			name:  "success/from an instance",
+			value: NewHash(sha256.New()),
+		},
+		{
+			name:  "success/from a factory",
+			value: NewHashFromFactory(sha256.New),
+		},
+		{
+			name:  "success/without marshaling",
+			value: NewHash(hashWithoutMarshaling{sha256.New()}),
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			prefixedHash := data.value.WithPrefix("prefix")
+
+			for _, hashData := range []string{"one", "two", "one"} {
+				got := prefixedHash.ApplyTo(hashData)
+
+				want := data.value.ApplyTo("prefix" + hashData)
+				assert.Equal(test, want, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package powValueTypes
+
+import (
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+type Signature struct {
+	keyID    string
+	rawValue []byte
+}
+
+func NewSignature(keyID string, rawValue []byte) (Signature, error) {
+	if keyID == "" {
+		return Signature{}, errors.New("signature key ID cannot be empty")
+	}
+	if len(rawValue) == 0 {
+		return Signature{}, errors.New("signature cannot be empty")
+	}
+
+	value := Signature{
+		keyID:    keyID,
+		rawValue: rawValue,
+	}
+	return value, nil
+}
+
+func ParseSignature(keyID string, rawValue string) (Signature, error) {
+	parsedRawValue, err := hex.DecodeString(rawValue)
+	if err != nil {
+		return Signature{}, fmt.Errorf("unable to decode the hex string: %w", err)
+	}
+
+	value, err := NewSignature(keyID, parsedRawValue)
+	if err != nil {
+		return Signature{}, fmt.Errorf("unable to construct the signature: %w", err)
+	}
+
+	return value, nil
+}
+
+func (value Signature) KeyID() string {
+	return value.keyID
+}
+
+func (value Signature) ToBytes() []byte {
+	return value.rawValue
+}
+
+func (value Signature) ToString() string {
+	return hex.EncodeToString(value.rawValue)
+}
@@ -0,0 +1,141 @@
+package powValueTypes
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewSignature(test *testing.T) {
+	type args struct {
+		keyID    string
+		rawValue []byte
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		want    Signature
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				keyID:    "key-1",
+				rawValue: []byte("dummy"),
+			},
+			want: Signature{
+				keyID:    "key-1",
+				rawValue: []byte("dummy"),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/empty key ID",
+			args: args{
+				keyID:    "",
+				rawValue: []byte("dummy"),
+			},
+			want:    Signature{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/empty signature",
+			args: args{
+				keyID:    "key-1",
+				rawValue: []byte{},
+			},
+			want:    Signature{},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err := NewSignature(data.args.keyID, data.args.rawValue)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestParseSignature(test *testing.T) {
+	type args struct {
+		keyID    string
+		rawValue string
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		want    Signature
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				keyID:    "key-1",
+				rawValue: "64756d6d79",
+			},
+			want: Signature{
+				keyID:    "key-1",
+				rawValue: []byte("dummy"),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/unable to decode the hex string",
+			args: args{
+				keyID:    "key-1",
+				rawValue: "dummy",
+			},
+			want:    Signature{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/unable to construct the signature",
+			args: args{
+				keyID:    "",
+				rawValue: "64756d6d79",
+			},
+			want:    Signature{},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err := ParseSignature(data.args.keyID, data.args.rawValue)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestSignature_KeyID(test *testing.T) {
+	value := Signature{
+		keyID:    "key-1",
+		rawValue: []byte("dummy"),
+	}
+	got := value.KeyID()
+
+	assert.Equal(test, "key-1", got)
+}
+
+func TestSignature_ToBytes(test *testing.T) {
+	value := Signature{
+		keyID:    "key-1",
+		rawValue: []byte("dummy"),
+	}
+	got := value.ToBytes()
+
+	assert.Equal(test, []byte("dummy"), got)
+}
+
+func TestSignature_ToString(test *testing.T) {
+	value := Signature{
+		keyID:    "key-1",
+		rawValue: []byte("dummy"),
+	}
+	got := value.ToString()
+
+	assert.Equal(test, "64756d6d79", got)
+}
@@ -0,0 +1,129 @@
+package powValueTypes
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+)
+
+const (
+	TargetRepresentationBase = 16
+)
+
+type Target struct {
+	rawValue *big.Int
+}
+
+func NewTarget(rawValue *big.Int) (Target, error) {
+	if rawValue.Sign() <= 0 {
+		return Target{}, errors.New("target must be positive")
+	}
+
+	value := Target{
+		rawValue: rawValue,
+	}
+	return value, nil
+}
+
+func NewTargetFromBitIndex(targetBitIndex TargetBitIndex) Target {
+	rawValue := big.NewInt(0)
+	rawValue.SetBit(rawValue, targetBitIndex.ToInt(), 1)
+
+	return Target{
+		rawValue: rawValue,
+	}
+}
+
+// the difficulty is relative to the maximal target, which is fit by any
+// hash sum of the specified size (similar to the difficulty in Bitcoin);
+// so the difficulty 2^n is equivalent to n leading zero bits
+func NewTargetFromDifficulty(
+	hashSizeInBits int,
+	difficulty float64,
+) (Target, error) {
+	if math.IsNaN(difficulty) || math.IsInf(difficulty, 0) {
+		return Target{}, errors.New("difficulty must be finite")
+	}
+	if difficulty < 1 {
+		return Target{}, errors.New("difficulty cannot be less than one")
+	}
+
+	maxRawValue := big.NewInt(0)
+	maxRawValue.SetBit(maxRawValue, hashSizeInBits, 1)
+
+	// the zero precision of the result means the maximal one of the operands,
+	// so the target isn't truncated
+	rawValue, _ := new(big.Float).
+		Quo(new(big.Float).SetInt(maxRawValue), big.NewFloat(difficulty)).
+		Int(nil)
+
+	value, err := NewTarget(rawValue)
+	if err != nil {
+		return Target{}, fmt.Errorf("unable to construct the target: %w", err)
+	}
+
+	return value, nil
+}
+
+func ParseTarget(rawValue string) (Target, error) {
+	parsedRawValue := big.NewInt(0)
+	if _, isParsed := parsedRawValue.SetString(
+		rawValue,
+		TargetRepresentationBase,
+	); !isParsed {
+		return Target{}, errors.New("unable to parse the big integer")
+	}
+
+	value, err := NewTarget(parsedRawValue)
+	if err != nil {
+		return Target{}, fmt.Errorf("unable to construct the target: %w", err)
+	}
+
+	return value, nil
+}
+
+func (value Target) LeadingZeroBitCount(
+	hashSizeInBits int,
+) (LeadingZeroBitCount, error) {
+	// hash sums below the target are guaranteed to have at least this number
+	// of leading zero bits
+	maxFitRawValue := big.NewInt(0).Sub(value.rawValue, big.NewInt(1))
+
+	result, err :=
+		NewLeadingZeroBitCount(hashSizeInBits - maxFitRawValue.BitLen())
+	if err != nil {
+		return LeadingZeroBitCount{}, fmt.Errorf(
+			"unable to construct the leading zero bit count: %w",
+			err,
+		)
+	}
+
+	return result, nil
+}
+
+// the probability that a random hash sum fits the target
+func (value Target) SuccessProbability(hashSizeInBits int) float64 {
+	hashSumCount := big.NewInt(0)
+	hashSumCount.SetBit(hashSumCount, hashSizeInBits, 1)
+
+	successProbability, _ := new(big.Float).
+		Quo(
+			new(big.Float).SetInt(value.rawValue),
+			new(big.Float).SetInt(hashSumCount),
+		).
+		Float64()
+	return min(successProbability, 1)
+}
+
+func (value Target) ExpectedAttemptCount(hashSizeInBits int) float64 {
+	return 1 / value.SuccessProbability(hashSizeInBits)
+}
+
+func (value Target) ToBigInt() *big.Int {
+	return value.rawValue
+}
+
+func (value Target) ToString() string {
+	return value.rawValue.Text(TargetRepresentationBase)
+}
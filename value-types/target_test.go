@@ -0,0 +1,488 @@
+package powValueTypes
+
+import (
+	"math"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTarget(test *testing.T) {
+	type args struct {
+		rawValue *big.Int
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		want    Target
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				rawValue: big.NewInt(1536),
+			},
+			want: Target{
+				rawValue: big.NewInt(1536),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/zero",
+			args: args{
+				rawValue: big.NewInt(0),
+			},
+			want:    Target{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/negative",
+			args: args{
+				rawValue: big.NewInt(-1536),
+			},
+			want:    Target{},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err := NewTarget(data.args.rawValue)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestNewTargetFromBitIndex(test *testing.T) {
+	type args struct {
+		targetBitIndex TargetBitIndex
+	}
+
+	for _, data := range []struct {
+		name string
+		args args
+		want Target
+	}{
+		{
+			name: "success/regular target bit index",
+			args: args{
+				targetBitIndex: TargetBitIndex{
+					rawValue: 10,
+				},
+			},
+			want: Target{
+				rawValue: big.NewInt(1024),
+			},
+		},
+		{
+			name: "success/zero target bit index",
+			args: args{
+				targetBitIndex: TargetBitIndex{
+					rawValue: 0,
+				},
+			},
+			want: Target{
+				rawValue: big.NewInt(1),
+			},
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got := NewTargetFromBitIndex(data.args.targetBitIndex)
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
+func TestNewTargetFromDifficulty(test *testing.T) {
+	type args struct {
+		hashSizeInBits int
+		difficulty     float64
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		want    Target
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/minimal difficulty",
+			args: args{
+				hashSizeInBits: 16,
+				difficulty:     1,
+			},
+			want: Target{
+				rawValue: big.NewInt(65536),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/power of two",
+			args: args{
+				hashSizeInBits: 16,
+				difficulty:     64,
+			},
+			want: Target{
+				rawValue: big.NewInt(1024),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/fractional difficulty",
+			args: args{
+				hashSizeInBits: 16,
+				difficulty:     1.5,
+			},
+			want: Target{
+				rawValue: big.NewInt(43690),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/large hash size",
+			args: args{
+				hashSizeInBits: 256,
+				difficulty:     3,
+			},
+			want: func() Target {
+				rawValue, isParsed := big.NewInt(0).SetString(
+					"55555555555555555555555555555555"+
+						"55555555555555555555555555555555",
+					16,
+				)
+				require.True(test, isParsed)
+
+				return Target{
+					rawValue: rawValue,
+				}
+			}(),
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/difficulty is less than one",
+			args: args{
+				hashSizeInBits: 16,
+				difficulty:     0.5,
+			},
+			want:    Target{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/difficulty isn't finite",
+			args: args{
+				hashSizeInBits: 16,
+				difficulty:     math.Inf(1),
+			},
+			want:    Target{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/target is zero",
+			args: args{
+				hashSizeInBits: 16,
+				difficulty:     100000,
+			},
+			want:    Target{},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err := NewTargetFromDifficulty(
+				data.args.hashSizeInBits,
+				data.args.difficulty,
+			)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestParseTarget(test *testing.T) {
+	type args struct {
+		rawValue string
+	}
+
+	for _, data := range []struct {
+		name    string
+		args    args
+		want    Target
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success",
+			args: args{
+				rawValue: "600",
+			},
+			want: Target{
+				rawValue: big.NewInt(1536),
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error/unable to parse the big integer",
+			args: args{
+				rawValue: "dummy",
+			},
+			want:    Target{},
+			wantErr: assert.Error,
+		},
+		{
+			name: "error/unable to construct the target",
+			args: args{
+				rawValue: "0",
+			},
+			want:    Target{},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			got, err := ParseTarget(data.args.rawValue)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestTarget_LeadingZeroBitCount(test *testing.T) {
+	type fields struct {
+		rawValue *big.Int
+	}
+	type args struct {
+		hashSizeInBits int
+	}
+
+	for _, data := range []struct {
+		name    string
+		fields  fields
+		args    args
+		want    LeadingZeroBitCount
+		wantErr assert.ErrorAssertionFunc
+	}{
+		{
+			name: "success/power of two",
+			fields: fields{
+				rawValue: big.NewInt(1024),
+			},
+			args: args{
+				hashSizeInBits: 16,
+			},
+			want: LeadingZeroBitCount{
+				rawValue: 6,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/arbitrary target",
+			fields: fields{
+				rawValue: big.NewInt(1536),
+			},
+			args: args{
+				hashSizeInBits: 16,
+			},
+			want: LeadingZeroBitCount{
+				rawValue: 5,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "success/minimal target",
+			fields: fields{
+				rawValue: big.NewInt(1),
+			},
+			args: args{
+				hashSizeInBits: 16,
+			},
+			want: LeadingZeroBitCount{
+				rawValue: 16,
+			},
+			wantErr: assert.NoError,
+		},
+		{
+			name: "error",
+			fields: fields{
+				rawValue: big.NewInt(65537),
+			},
+			args: args{
+				hashSizeInBits: 16,
+			},
+			want:    LeadingZeroBitCount{},
+			wantErr: assert.Error,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			value := Target{
+				rawValue: data.fields.rawValue,
+			}
+			got, err := value.LeadingZeroBitCount(data.args.hashSizeInBits)
+
+			assert.Equal(test, data.want, got)
+			data.wantErr(test, err)
+		})
+	}
+}
+
+func TestTarget_SuccessProbability(test *testing.T) {
+	type fields struct {
+		rawValue *big.Int
+	}
+	type args struct {
+		hashSizeInBits int
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		args   args
+		want   float64
+	}{
+		{
+			name: "success/power of two",
+			fields: fields{
+				rawValue: big.NewInt(1024),
+			},
+			args: args{
+				hashSizeInBits: 16,
+			},
+			want: 1.0 / 64,
+		},
+		{
+			name: "success/arbitrary target",
+			fields: fields{
+				rawValue: big.NewInt(1536),
+			},
+			args: args{
+				hashSizeInBits: 16,
+			},
+			want: 3.0 / 128,
+		},
+		{
+			name: "success/target exceeds the hash size",
+			fields: fields{
+				rawValue: big.NewInt(1 << 20),
+			},
+			args: args{
+				hashSizeInBits: 16,
+			},
+			want: 1,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			value := Target{
+				rawValue: data.fields.rawValue,
+			}
+			got := value.SuccessProbability(data.args.hashSizeInBits)
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
+func TestTarget_ExpectedAttemptCount(test *testing.T) {
+	type fields struct {
+		rawValue *big.Int
+	}
+	type args struct {
+		hashSizeInBits int
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		args   args
+		want   float64
+	}{
+		{
+			name: "success/power of two",
+			fields: fields{
+				rawValue: big.NewInt(1024),
+			},
+			args: args{
+				hashSizeInBits: 16,
+			},
+			want: 64,
+		},
+		{
+			name: "success/target exceeds the hash size",
+			fields: fields{
+				rawValue: big.NewInt(1 << 20),
+			},
+			args: args{
+				hashSizeInBits: 16,
+			},
+			want: 1,
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			value := Target{
+				rawValue: data.fields.rawValue,
+			}
+			got := value.ExpectedAttemptCount(data.args.hashSizeInBits)
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
+func TestTarget_ToBigInt(test *testing.T) {
+	type fields struct {
+		rawValue *big.Int
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		want   *big.Int
+	}{
+		{
+			name: "success",
+			fields: fields{
+				rawValue: big.NewInt(1536),
+			},
+			want: big.NewInt(1536),
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			value := Target{
+				rawValue: data.fields.rawValue,
+			}
+			got := value.ToBigInt()
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}
+
+func TestTarget_ToString(test *testing.T) {
+	type fields struct {
+		rawValue *big.Int
+	}
+
+	for _, data := range []struct {
+		name   string
+		fields fields
+		want   string
+	}{
+		{
+			name: "success",
+			fields: fields{
+				rawValue: big.NewInt(1536),
+			},
+			want: "600",
+		},
+	} {
+		test.Run(data.name, func(test *testing.T) {
+			value := Target{
+				rawValue: data.fields.rawValue,
+			}
+			got := value.ToString()
+
+			assert.Equal(test, data.want, got)
+		})
+	}
+}